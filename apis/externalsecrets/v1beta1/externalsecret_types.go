@@ -16,6 +16,7 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -90,8 +91,14 @@ type ExternalSecretTemplate struct {
 	// EngineVersion specifies the template engine version
 	// that should be used to compile/execute the
 	// template specified in .data and .templateFrom[].
+	// v1 and v2 use Go templates, cel evaluates CEL expressions.
 	// +kubebuilder:default="v2"
 	EngineVersion TemplateEngineVersion `json:"engineVersion,omitempty"`
+	// FetchRemoteMetadata fetches the provider metadata of the keys of .spec.data
+	// in addition to their values, so it can be read with remoteMetadata.
+	// The values are not changed. Only used by ExternalSecrets.
+	// +optional
+	FetchRemoteMetadata bool `json:"fetchRemoteMetadata,omitempty"`
 	// +optional
 	Metadata ExternalSecretTemplateMetadata `json:"metadata,omitempty"`
 	// +kubebuilder:default="Replace"
@@ -100,8 +107,31 @@ type ExternalSecretTemplate struct {
 	Data map[string]string `json:"data,omitempty"`
 	// +optional
 	TemplateFrom []TemplateFrom `json:"templateFrom,omitempty"`
+	// SecretTemplateRefs references SecretTemplates or ClusterSecretTemplates
+	// whose named templates can be used in .data and .templateFrom[].
+	// Only supported by engine version v2.
+	// +optional
+	SecretTemplateRefs []SecretTemplateRef `json:"secretTemplateRefs,omitempty"`
+	// ContentTypes declares the content type of rendered data keys.
+	// Keys without a content type are written as rendered.
+	// +optional
+	ContentTypes map[string]TemplateContentType `json:"contentTypes,omitempty"`
 }
 
+// TemplateContentType defines how the rendered value of a data key is written to the secret.
+// +kubebuilder:validation:Enum=Text;Binary;Base64
+type TemplateContentType string
+
+const (
+	// TemplateContentTypeText requires the rendered value to be valid UTF-8.
+	TemplateContentTypeText TemplateContentType = "Text"
+	// TemplateContentTypeBinary writes the rendered bytes as they are.
+	TemplateContentTypeBinary TemplateContentType = "Binary"
+	// TemplateContentTypeBase64 decodes the base64 encoded rendered value,
+	// so templates can produce binary values like keystores.
+	TemplateContentTypeBase64 TemplateContentType = "Base64"
+)
+
 // +kubebuilder:validation:Enum=Replace;Merge
 type TemplateMergePolicy string
 
@@ -110,12 +140,13 @@ const (
 	MergePolicyMerge   TemplateMergePolicy = "Merge"
 )
 
-// +kubebuilder:validation:Enum=v1;v2
+// +kubebuilder:validation:Enum=v1;v2;cel
 type TemplateEngineVersion string
 
 const (
-	TemplateEngineV1 TemplateEngineVersion = "v1"
-	TemplateEngineV2 TemplateEngineVersion = "v2"
+	TemplateEngineV1  TemplateEngineVersion = "v1"
+	TemplateEngineV2  TemplateEngineVersion = "v2"
+	TemplateEngineCEL TemplateEngineVersion = "cel"
 )
 
 type TemplateFrom struct {
@@ -129,12 +160,20 @@ type TemplateFrom struct {
 	Literal *string `json:"literal,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Values;KeysAndValues
+// TemplateScope defines how a template is rendered.
+// Env, Properties, INI and TOML render the template to a map like KeysAndValues
+// and write the map in the respective file format to the key of the template.
+// They are only supported by engine version v2.
+// +kubebuilder:validation:Enum=Values;KeysAndValues;Env;Properties;INI;TOML
 type TemplateScope string
 
 const (
 	TemplateScopeValues        TemplateScope = "Values"
 	TemplateScopeKeysAndValues TemplateScope = "KeysAndValues"
+	TemplateScopeEnv           TemplateScope = "Env"
+	TemplateScopeProperties    TemplateScope = "Properties"
+	TemplateScopeINI           TemplateScope = "INI"
+	TemplateScopeTOML          TemplateScope = "TOML"
 )
 
 // +kubebuilder:validation:Enum=Data;Annotations;Labels
@@ -280,6 +319,11 @@ type ExternalSecretDataFromRemoteRef struct {
 }
 
 type ExternalSecretRewrite struct {
+	// Used to only apply the rewrite operation to keys matching the regular expression.
+	// Keys which do not match are passed on unchanged.
+	// +optional
+	Match string `json:"match,omitempty"`
+
 	// Used to rewrite with regular expressions.
 	// The resulting key will be the output of a regexp.ReplaceAll operation.
 	// +optional
@@ -289,6 +333,14 @@ type ExternalSecretRewrite struct {
 	// The resulting key will be the output of the template applied by the operation.
 	// +optional
 	Transform *ExternalSecretRewriteTransform `json:"transform,omitempty"`
+
+	// Used to keep or drop keys matching a regular expression.
+	// +optional
+	Filter *ExternalSecretRewriteFilter `json:"filter,omitempty"`
+
+	// Used to unpack JSON object values into multiple keys.
+	// +optional
+	Merge *ExternalSecretRewriteMerge `json:"merge,omitempty"`
 }
 
 type ExternalSecretRewriteRegexp struct {
@@ -301,9 +353,37 @@ type ExternalSecretRewriteRegexp struct {
 type ExternalSecretRewriteTransform struct {
 	// Used to define the template to apply on the secret name.
 	// `.value ` will specify the secret name in the template.
+	// `.name`, `.path`, `.tags` and `.version` specify the metadata of the secret
+	// found by the provider, if the provider returns it.
 	Template string `json:"template"`
 }
 
+// +kubebuilder:validation:Enum=Keep;Drop
+type ExternalSecretRewriteFilterAction string
+
+const (
+	// RewriteFilterKeep keeps the keys matching the filter and drops all others.
+	RewriteFilterKeep ExternalSecretRewriteFilterAction = "Keep"
+	// RewriteFilterDrop drops the keys matching the filter.
+	RewriteFilterDrop ExternalSecretRewriteFilterAction = "Drop"
+)
+
+type ExternalSecretRewriteFilter struct {
+	// Used to define the regular expression the keys are matched against.
+	Source string `json:"source"`
+	// Used to define whether matching keys are kept or dropped.
+	// +optional
+	// +kubebuilder:default="Keep"
+	Action ExternalSecretRewriteFilterAction `json:"action,omitempty"`
+}
+
+type ExternalSecretRewriteMerge struct {
+	// Used to define the prefix of the keys unpacked from a JSON object.
+	// Values which are not strings are stored as JSON.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+}
+
 type ExternalSecretFind struct {
 	// A root path to start the find operations.
 	// +optional
@@ -433,6 +513,8 @@ const (
 	ReasonDeprecated           = "ParameterDeprecated"
 	ReasonUpdated              = "Updated"
 	ReasonDeleted              = "Deleted"
+	ReasonCleanupFailed        = "CleanupFailed"
+	ReasonMetadataFailed       = "MetadataFailed"
 )
 
 type ExternalSecretStatus struct {
@@ -444,11 +526,54 @@ type ExternalSecretStatus struct {
 	// SyncedResourceVersion keeps track of the last synced version
 	SyncedResourceVersion string `json:"syncedResourceVersion,omitempty"`
 
+	// SyncedTemplateVersion keeps track of the versions of the
+	// secret templates used by the last sync.
+	// +optional
+	SyncedTemplateVersion string `json:"syncedTemplateVersion,omitempty"`
+
+	// ExpirationTime is the earliest time one of the synced values expires
+	// at the provider. The secret is refreshed before that time even if
+	// the refresh interval has not passed yet.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
 	// +optional
 	Conditions []ExternalSecretStatusCondition `json:"conditions,omitempty"`
 
 	// Binding represents a servicebinding.io Provisioned Service reference to the secret
 	Binding corev1.LocalObjectReference `json:"binding,omitempty"`
+
+	// GeneratorStates keeps track of the values generated during the last sync.
+	// They are cleaned up once the target secret has been updated with newly generated values.
+	// +optional
+	GeneratorStates []ExternalSecretGeneratorState `json:"generatorStates,omitempty"`
+
+	// StaleGeneratorStates keeps track of generated values which are not in use anymore
+	// but have not been cleaned up yet. Cleaning them up is retried on every reconcile.
+	// +optional
+	StaleGeneratorStates []ExternalSecretGeneratorState `json:"staleGeneratorStates,omitempty"`
+}
+
+// ExternalSecretGeneratorState holds the state returned by a generator
+// which is used by one of the dataFrom entries.
+type ExternalSecretGeneratorState struct {
+	// DataFromIndex is the index of the dataFrom entry the state belongs to.
+	DataFromIndex int `json:"dataFromIndex"`
+
+	// GeneratorRef points to the generator which returned the state.
+	GeneratorRef GeneratorRef `json:"generatorRef"`
+
+	// State is the opaque state returned by the generator.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	State *apiextensions.JSON `json:"state"`
+
+	// Resource is the generator definition the values were generated with.
+	// It is passed to the generator on cleanup, so the values can be cleaned up
+	// even if the generator has been changed or deleted in the meantime.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Resource *apiextensions.JSON `json:"resource"`
 }
 
 // +kubebuilder:object:root=true
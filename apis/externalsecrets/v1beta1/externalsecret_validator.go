@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -25,6 +26,32 @@ import (
 
 type ExternalSecretValidator struct{}
 
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
+// TemplateValidator validates templates with the engine which renders them.
+// The engines can not be imported by this package, they register
+// their validator with RegisterTemplateValidator.
+// Templates are only parsed: rendering them is not bounded in time or memory,
+// so it must not happen in the webhook.
+type TemplateValidator interface {
+	// ValidateTemplate parses the templates and returns an error
+	// if one of them can not be parsed.
+	ValidateTemplate(engine TemplateEngineVersion, tpl map[string][]byte) error
+	// ValidateTransform parses the template of a rewrite transform operation.
+	ValidateTransform(tpl string) error
+}
+
+var templateValidator TemplateValidator
+
+// RegisterTemplateValidator sets the validator used for the templates of ExternalSecrets.
+// Without a registered validator templates are not validated.
+func RegisterTemplateValidator(v TemplateValidator) {
+	templateValidator = v
+}
+
 func (esv *ExternalSecretValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	return validateExternalSecret(obj)
 }
@@ -65,9 +92,71 @@ func validateExternalSecret(obj runtime.Object) (admission.Warnings, error) {
 	}
 
 	errs = validateDuplicateKeys(es, errs)
+	errs = validateRewrites(es, errs)
+	errs = errors.Join(errs, validateTemplates(es))
 	return nil, errs
 }
 
+func validateRewrites(es *ExternalSecret, errs error) error {
+	for i, ref := range es.Spec.DataFrom {
+		for j, rewrite := range ref.Rewrite {
+			expressions := map[string]string{"match": rewrite.Match}
+			if rewrite.Regexp != nil {
+				expressions["regexp.source"] = rewrite.Regexp.Source
+			}
+			if rewrite.Filter != nil {
+				expressions["filter.source"] = rewrite.Filter.Source
+			}
+			for _, field := range []string{"match", "regexp.source", "filter.source"} {
+				if _, err := regexp.Compile(expressions[field]); err != nil {
+					errs = errors.Join(errs, fmt.Errorf("invalid dataFrom[%d] rewrite[%d] %s: %w", i, j, field, err))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// validateTemplates rejects templates which can not be parsed by the configured engine.
+func validateTemplates(es *ExternalSecret) error {
+	if templateValidator == nil {
+		return nil
+	}
+	var errs error
+	collect := func(prefix string, err error) {
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", prefix, err))
+		}
+	}
+
+	if tpl := es.Spec.Target.Template; tpl != nil {
+		if len(tpl.Data) > 0 {
+			tplData := make(map[string][]byte, len(tpl.Data))
+			for k, v := range tpl.Data {
+				tplData[k] = []byte(v)
+			}
+			collect("invalid template data", templateValidator.ValidateTemplate(tpl.EngineVersion, tplData))
+		}
+		for i, from := range tpl.TemplateFrom {
+			if from.Literal == nil {
+				continue
+			}
+			literal := map[string][]byte{*from.Literal: []byte(*from.Literal)}
+			collect(fmt.Sprintf("invalid templateFrom[%d] literal", i), templateValidator.ValidateTemplate(tpl.EngineVersion, literal))
+		}
+	}
+
+	for i, ref := range es.Spec.DataFrom {
+		for j, rewrite := range ref.Rewrite {
+			if rewrite.Transform == nil {
+				continue
+			}
+			collect(fmt.Sprintf("invalid dataFrom[%d] rewrite[%d] transform", i, j), templateValidator.ValidateTransform(rewrite.Transform.Template))
+		}
+	}
+	return errs
+}
+
 func validateDuplicateKeys(es *ExternalSecret, errs error) error {
 	if es.Spec.Target.DeletionPolicy == DeletionPolicyRetain {
 		seenKeys := make(map[string]struct{})
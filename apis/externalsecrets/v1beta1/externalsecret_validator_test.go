@@ -15,6 +15,9 @@ limitations under the License.
 package v1beta1
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -123,6 +126,28 @@ either data or dataFrom should be specified`,
 				},
 			},
 		},
+		{
+			name: "invalid rewrite expressions",
+			obj: &ExternalSecret{
+				Spec: ExternalSecretSpec{
+					DataFrom: []ExternalSecretDataFromRemoteRef{
+						{
+							Rewrite: []ExternalSecretRewrite{
+								{
+									Match:  "(",
+									Filter: &ExternalSecretRewriteFilter{Source: "^valid$"},
+								},
+								{
+									Regexp: &ExternalSecretRewriteRegexp{Source: "[a-"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedErr: "invalid dataFrom[0] rewrite[0] match: error parsing regexp: missing closing ): `(`\n" +
+				"invalid dataFrom[0] rewrite[1] regexp.source: error parsing regexp: missing closing ]: `[a-`",
+		},
 		{
 			name: "duplicate secretKeys",
 			obj: &ExternalSecret{
@@ -176,3 +201,70 @@ either data or dataFrom should be specified`,
 		})
 	}
 }
+
+type fakeTemplateValidator struct {
+	calls []string
+}
+
+func (v *fakeTemplateValidator) ValidateTemplate(engine TemplateEngineVersion, tpl map[string][]byte) error {
+	for k, val := range tpl {
+		v.calls = append(v.calls, fmt.Sprintf("%s/%s=%s", engine, k, val))
+		if string(val) == "invalid" {
+			return errors.New("parse error")
+		}
+	}
+	return nil
+}
+
+func (v *fakeTemplateValidator) ValidateTransform(tpl string) error {
+	v.calls = append(v.calls, "transform="+tpl)
+	return errors.New("parse error")
+}
+
+func TestValidateExternalSecretTemplates(t *testing.T) {
+	fake := &fakeTemplateValidator{}
+	RegisterTemplateValidator(fake)
+	defer RegisterTemplateValidator(nil)
+
+	literal := "invalid"
+	es := &ExternalSecret{
+		Spec: ExternalSecretSpec{
+			Target: ExternalSecretTarget{
+				Template: &ExternalSecretTemplate{
+					EngineVersion: TemplateEngineV2,
+					Data:          map[string]string{"foo": "{{ .bar }}"},
+					TemplateFrom: []TemplateFrom{
+						{ConfigMap: &TemplateRef{}},
+						{Literal: &literal},
+					},
+				},
+			},
+			Data: []ExternalSecretData{
+				{SecretKey: "bar"},
+			},
+			DataFrom: []ExternalSecretDataFromRemoteRef{
+				{
+					Rewrite: []ExternalSecretRewrite{
+						{Regexp: &ExternalSecretRewriteRegexp{}},
+						{Transform: &ExternalSecretRewriteTransform{Template: "{{ .value }}"}},
+					},
+				},
+			},
+		},
+	}
+	warnings, err := validateExternalSecret(es)
+	if err == nil || err.Error() != "invalid templateFrom[1] literal: parse error\ninvalid dataFrom[0] rewrite[1] transform: parse error" {
+		t.Errorf("validateExternalSecret() returned an unexpected error: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("validateExternalSecret() returned unexpected warnings: %v", warnings)
+	}
+	expectedCalls := []string{
+		"v2/foo={{ .bar }}",
+		"v2/invalid=invalid",
+		"transform={{ .value }}",
+	}
+	if !reflect.DeepEqual(fake.calls, expectedCalls) {
+		t.Errorf("unexpected validator calls: got: %v, expected: %v", fake.calls, expectedCalls)
+	}
+}
@@ -16,6 +16,7 @@ package v1beta1
 
 import (
 	"context"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -66,6 +67,18 @@ type Provider interface {
 // +k8s:deepcopy-gen:interfaces=nil
 // +k8s:deepcopy-gen=nil
 
+// StoreCapabilitiesProvider is implemented by providers whose capabilities
+// depend on the configuration of the store.
+type StoreCapabilitiesProvider interface {
+	// StoreCapabilities returns the Capabilities (Read, Write, ReadWrite) of the given store.
+	StoreCapabilities(store GenericStore) SecretStoreCapabilities
+}
+
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
 // SecretsClient provides access to secrets.
 type SecretsClient interface {
 	// GetSecret returns a single secret from the provider
@@ -96,6 +109,53 @@ type SecretsClient interface {
 	Close(ctx context.Context) error
 }
 
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
+// SecretsMetadataClient is implemented by secrets clients which return
+// the metadata of the secrets they read.
+type SecretsMetadataClient interface {
+	// GetSecretMetadata returns the metadata of a single secret,
+	// e.g. the version that is read with the given reference.
+	GetSecretMetadata(ctx context.Context, ref ExternalSecretDataRemoteRef) (SecretMetadata, error)
+
+	// GetAllSecretsWithMetadata returns multiple k/v pairs from the provider
+	// and the metadata of the secret each key was found at.
+	GetAllSecretsWithMetadata(ctx context.Context, ref ExternalSecretFind) (map[string][]byte, map[string]SecretMetadata, error)
+}
+
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
+// SecretsExpirationClient is implemented by secrets clients which return
+// short-lived values, e.g. dynamic credentials issued on every read.
+type SecretsExpirationClient interface {
+	// Expiration returns the earliest time a value read by the client expires.
+	// It returns the zero time if none of the values expire.
+	Expiration() time.Time
+}
+
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
+// SecretMetadata describes a secret found at the provider.
+type SecretMetadata struct {
+	// Path is the full path of the secret at the provider.
+	Path string
+	// Tags are the tags or labels of the secret.
+	Tags map[string]string
+	// Version is the version of the secret.
+	Version string
+	// CreatedTime is the time the version of the secret was created.
+	CreatedTime time.Time
+}
+
 var NoSecretErr = NoSecretError{}
 
 // NoSecretError shall be returned when a GetSecret can not find the
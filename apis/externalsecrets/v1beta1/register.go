@@ -68,9 +68,27 @@ var (
 	ClusterSecretStoreGroupVersionKind = SchemeGroupVersion.WithKind(ClusterSecretStoreKind)
 )
 
+// SecretTemplate type metadata.
+var (
+	SecretTemplateKind             = reflect.TypeOf(SecretTemplate{}).Name()
+	SecretTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: SecretTemplateKind}.String()
+	SecretTemplateKindAPIVersion   = SecretTemplateKind + "." + SchemeGroupVersion.String()
+	SecretTemplateGroupVersionKind = SchemeGroupVersion.WithKind(SecretTemplateKind)
+)
+
+// ClusterSecretTemplate type metadata.
+var (
+	ClusterSecretTemplateKind             = reflect.TypeOf(ClusterSecretTemplate{}).Name()
+	ClusterSecretTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterSecretTemplateKind}.String()
+	ClusterSecretTemplateKindAPIVersion   = ClusterSecretTemplateKind + "." + SchemeGroupVersion.String()
+	ClusterSecretTemplateGroupVersionKind = SchemeGroupVersion.WithKind(ClusterSecretTemplateKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ExternalSecret{}, &ExternalSecretList{})
 	SchemeBuilder.Register(&ClusterExternalSecret{}, &ClusterExternalSecretList{})
 	SchemeBuilder.Register(&SecretStore{}, &SecretStoreList{})
 	SchemeBuilder.Register(&ClusterSecretStore{}, &ClusterSecretStoreList{})
+	SchemeBuilder.Register(&SecretTemplate{}, &SecretTemplateList{})
+	SchemeBuilder.Register(&ClusterSecretTemplate{}, &ClusterSecretTemplateList{})
 }
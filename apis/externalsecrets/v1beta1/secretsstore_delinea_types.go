@@ -28,16 +28,23 @@ type DelineaProviderSecretRef struct {
 }
 
 // See https://github.com/DelineaXPM/dsv-sdk-go/blob/main/vault/vault.go.
+// Set SecretServer instead of ClientID, ClientSecret and Tenant to use an on-premises Secret Server.
 type DelineaProvider struct {
 
 	// ClientID is the non-secret part of the credential.
-	ClientID *DelineaProviderSecretRef `json:"clientId"`
+	// Required for DevOps Secrets Vault.
+	// +optional
+	ClientID *DelineaProviderSecretRef `json:"clientId,omitempty"`
 
 	// ClientSecret is the secret part of the credential.
-	ClientSecret *DelineaProviderSecretRef `json:"clientSecret"`
+	// Required for DevOps Secrets Vault.
+	// +optional
+	ClientSecret *DelineaProviderSecretRef `json:"clientSecret,omitempty"`
 
 	// Tenant is the chosen hostname / site name.
-	Tenant string `json:"tenant"`
+	// Required for DevOps Secrets Vault.
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
 
 	// URLTemplate
 	// If unset, defaults to "https://%s.secretsvaultcloud.%s/v1/%s%s".
@@ -48,4 +55,27 @@ type DelineaProvider struct {
 	// If unset, defaults to "com".
 	// +optional
 	TLD string `json:"tld,omitempty"`
+
+	// SecretServer configures the provider to use a Delinea Secret Server
+	// instead of DevOps Secrets Vault.
+	// +optional
+	SecretServer *DelineaSecretServer `json:"secretServer,omitempty"`
+}
+
+// DelineaSecretServer configures access to a Delinea (formerly Thycotic) Secret Server.
+// The provider authenticates using the OAuth password grant.
+type DelineaSecretServer struct {
+
+	// ServerURL is the base URL of the Secret Server, e.g. "https://secretserver.example.com/SecretServer".
+	ServerURL string `json:"serverURL"`
+
+	// Username of the Secret Server user or application account.
+	Username *DelineaProviderSecretRef `json:"username"`
+
+	// Password of the Secret Server user or application account.
+	Password *DelineaProviderSecretRef `json:"password"`
+
+	// Domain is the Active Directory domain of the user, if any.
+	// +optional
+	Domain string `json:"domain,omitempty"`
 }
@@ -36,8 +36,27 @@ type AkeylessProvider struct {
 	// The provider for the CA bundle to use to validate Akeyless Gateway certificate.
 	// +optional
 	CAProvider *CAProvider `json:"caProvider,omitempty"`
+
+	// DynamicSecretFormat defines how the values of dynamic secrets are returned.
+	// `Raw` returns the response of the producer as is.
+	// `Flatten` unpacks the JSON credentials of the `value` field into the returned object
+	// and adds `ttl` and `expiration` if the producer reports a TTL.
+	// +kubebuilder:default=Raw
+	// +optional
+	DynamicSecretFormat AkeylessDynamicSecretFormat `json:"dynamicSecretFormat,omitempty"`
 }
 
+// AkeylessDynamicSecretFormat defines how the values of dynamic secrets are returned.
+// +kubebuilder:validation:Enum=Raw;Flatten
+type AkeylessDynamicSecretFormat string
+
+const (
+	// AkeylessDynamicSecretFormatRaw returns the response of the dynamic secret producer as is.
+	AkeylessDynamicSecretFormatRaw AkeylessDynamicSecretFormat = "Raw"
+	// AkeylessDynamicSecretFormatFlatten unpacks the credentials of the dynamic secret into a single object.
+	AkeylessDynamicSecretFormatFlatten AkeylessDynamicSecretFormat = "Flatten"
+)
+
 type AkeylessAuth struct {
 
 	// Reference to a Secret that contains the details
@@ -24,17 +24,27 @@ type OnePasswordAuth struct {
 }
 
 // OnePasswordAuthSecretRef holds secret references for 1Password credentials.
+// Exactly one of ConnectToken or ServiceAccountToken must be set.
 type OnePasswordAuthSecretRef struct {
 	// The ConnectToken is used for authentication to a 1Password Connect Server.
-	ConnectToken esmeta.SecretKeySelector `json:"connectTokenSecretRef"`
+	// +optional
+	ConnectToken esmeta.SecretKeySelector `json:"connectTokenSecretRef,omitempty"`
+
+	// The ServiceAccountToken is used to authenticate directly against the 1Password API
+	// with a 1Password service account, without a Connect Server.
+	// +optional
+	ServiceAccountToken *esmeta.SecretKeySelector `json:"serviceAccountSecretRef,omitempty"`
 }
 
 // OnePasswordProvider configures a store to sync secrets using the 1Password Secret Manager provider.
 type OnePasswordProvider struct {
 	// Auth defines the information necessary to authenticate against OnePassword Connect Server
+	// or, when a service account token is used, against the 1Password API
 	Auth *OnePasswordAuth `json:"auth"`
-	// ConnectHost defines the OnePassword Connect Server to connect to
-	ConnectHost string `json:"connectHost"`
+	// ConnectHost defines the OnePassword Connect Server to connect to.
+	// Required when authenticating with a Connect token, ignored for service accounts.
+	// +optional
+	ConnectHost string `json:"connectHost,omitempty"`
 	// Vaults defines which OnePassword vaults to search in which order
 	Vaults map[string]int `json:"vaults"`
 }
@@ -59,6 +59,55 @@ type WebhookProvider struct {
 	// The provider for the CA bundle to use to validate webhook server certificate.
 	// +optional
 	CAProvider *WebhookCAProvider `json:"caProvider,omitempty"`
+
+	// Push defines the request used to push a secret.
+	// PushSecret is not supported if unset.
+	// +optional
+	Push *WebhookRequest `json:"push,omitempty"`
+
+	// Delete defines the request used to delete a pushed secret.
+	// A 404 response is treated as already deleted.
+	// +optional
+	Delete *WebhookRequest `json:"delete,omitempty"`
+
+	// Exists defines the request used to check whether a pushed secret exists.
+	// A successful response means the secret exists, a 404 response means it does not.
+	// +optional
+	Exists *WebhookRequest `json:"exists,omitempty"`
+
+	// List defines the request used to find secrets with dataFrom.find.
+	// +optional
+	List *WebhookListRequest `json:"list,omitempty"`
+}
+
+// WebhookRequest defines a templated request of the webhook provider.
+// URL, headers and body are templates using the same data as the read request.
+type WebhookRequest struct {
+	// Webhook Method
+	// +optional, defaults to POST for push, DELETE for delete and GET for exists and list
+	Method string `json:"method,omitempty"`
+
+	// Webhook url to call
+	URL string `json:"url"`
+
+	// Headers
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body
+	// +optional
+	Body string `json:"body,omitempty"`
+}
+
+// WebhookListRequest defines the request used to list secrets.
+type WebhookListRequest struct {
+	WebhookRequest `json:",inline"`
+
+	// Result formatting. The JSON path must select either an object of
+	// secret names to values, or a list of secret names which are then
+	// fetched one by one with the read request.
+	// +optional
+	Result WebhookResult `json:"result,omitempty"`
 }
 
 type WebhookCAProviderType string
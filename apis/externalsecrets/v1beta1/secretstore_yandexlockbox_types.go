@@ -40,4 +40,9 @@ type YandexLockboxProvider struct {
 	// The provider for the CA bundle to use to validate Yandex.Cloud server certificate.
 	// +optional
 	CAProvider *YandexLockboxCAProvider `json:"caProvider,omitempty"`
+
+	// ID of the folder in which secrets are created by PushSecret and listed by dataFrom.find.
+	// Secrets in the folder are addressed by their name.
+	// +optional
+	FolderID string `json:"folderID,omitempty"`
 }
@@ -0,0 +1,100 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretTemplateSpec defines named templates which can be
+// used by the templates of ExternalSecrets.
+type SecretTemplateSpec struct {
+	// Templates maps the name of a template to its definition.
+	// The templates are parsed by the v2 engine and can be used
+	// with `{{ include "name" . }}` or `{{ template "name" . }}`.
+	// +kubebuilder:validation:MinProperties=1
+	Templates map[string]string `json:"templates"`
+}
+
+// ClusterSecretTemplateSpec defines named templates which can be
+// used by the templates of ExternalSecrets in any namespace.
+type ClusterSecretTemplateSpec struct {
+	// Templates maps the name of a template to its definition.
+	// The templates are parsed by the v2 engine and can be used
+	// with `{{ include "name" . }}` or `{{ template "name" . }}`.
+	// +kubebuilder:validation:MinProperties=1
+	Templates map[string]string `json:"templates"`
+
+	// Used to constrain a ClusterSecretTemplate to specific namespaces.
+	// If empty, the ClusterSecretTemplate can be used from any namespace.
+	// +optional
+	Conditions []ClusterSecretStoreCondition `json:"conditions,omitempty"`
+}
+
+// SecretTemplateRef references a SecretTemplate or ClusterSecretTemplate.
+type SecretTemplateRef struct {
+	// Kind of the referenced template library.
+	// +kubebuilder:validation:Enum=SecretTemplate;ClusterSecretTemplate
+	// +kubebuilder:default=SecretTemplate
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the referenced template library.
+	Name string `json:"name"`
+}
+
+// SecretTemplate is a library of named templates
+// which can be shared by ExternalSecrets of its namespace.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Namespaced,categories={externalsecrets},shortName=st
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type SecretTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SecretTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretTemplateList contains a list of SecretTemplate resources.
+type SecretTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretTemplate `json:"items"`
+}
+
+// ClusterSecretTemplate is a library of named templates
+// which can be shared by ExternalSecrets of all namespaces.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={externalsecrets},shortName=cst
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+type ClusterSecretTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterSecretTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterSecretTemplateList contains a list of ClusterSecretTemplate resources.
+type ClusterSecretTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSecretTemplate `json:"items"`
+}
@@ -26,10 +26,31 @@ import (
 // +k8s:deepcopy-gen:interfaces=nil
 // +k8s:deepcopy-gen=nil
 type Generator interface {
+	// Generate creates a new set of secret values.
+	// It may return an opaque state which identifies the generated values
+	// at the backend (e.g. a lease) and is later passed to Cleanup.
 	Generate(
 		ctx context.Context,
 		obj *apiextensions.JSON,
 		kube client.Client,
 		namespace string,
-	) (map[string][]byte, error)
+	) (map[string][]byte, GeneratorProviderState, error)
+
+	// Cleanup releases the values generated alongside the given state,
+	// e.g. by revoking a lease. It is called once the values are no longer in use.
+	Cleanup(
+		ctx context.Context,
+		obj *apiextensions.JSON,
+		state GeneratorProviderState,
+		kube client.Client,
+		namespace string,
+	) error
 }
+
+// GeneratorProviderState is an opaque state returned by a generator.
+// Generators which do not need to clean up after themselves return nil.
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+type GeneratorProviderState *apiextensions.JSON
@@ -0,0 +1,155 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// ClusterGeneratorSpec defines a generator which can be referenced from any namespace.
+type ClusterGeneratorSpec struct {
+	// Kind is the kind of the generator, it must match the spec set in generator.
+	Kind GeneratorKind `json:"kind"`
+
+	// Generator holds the spec of the generator.
+	Generator GeneratorSpec `json:"generator"`
+
+	// Used to constrain a ClusterGenerator to specific namespaces.
+	// If empty, the ClusterGenerator can be referenced from any namespace.
+	// +optional
+	Conditions []esv1beta1.ClusterSecretStoreCondition `json:"conditions,omitempty"`
+}
+
+// GeneratorKind is the kind of a generator.
+// +kubebuilder:validation:Enum=ACRAccessToken;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;JWT;MySQLUser;Password;PostgreSQLUser;RandomValue;ServiceAccountToken;SSHKey;STSSessionToken;TLSCertificate;VaultDynamicSecret;Webhook
+type GeneratorKind string
+
+const (
+	GeneratorKindACRAccessToken        GeneratorKind = "ACRAccessToken"
+	GeneratorKindECRAuthorizationToken GeneratorKind = "ECRAuthorizationToken"
+	GeneratorKindFake                  GeneratorKind = "Fake"
+	GeneratorKindGCRAccessToken        GeneratorKind = "GCRAccessToken"
+	GeneratorKindGithubAccessToken     GeneratorKind = "GithubAccessToken"
+	GeneratorKindJWT                   GeneratorKind = "JWT"
+	GeneratorKindMySQLUser             GeneratorKind = "MySQLUser"
+	GeneratorKindPassword              GeneratorKind = "Password"
+	GeneratorKindPostgreSQLUser        GeneratorKind = "PostgreSQLUser"
+	GeneratorKindRandomValue           GeneratorKind = "RandomValue"
+	GeneratorKindServiceAccountToken   GeneratorKind = "ServiceAccountToken"
+	GeneratorKindSSHKey                GeneratorKind = "SSHKey"
+	GeneratorKindSTSSessionToken       GeneratorKind = "STSSessionToken"
+	GeneratorKindTLSCertificate        GeneratorKind = "TLSCertificate"
+	GeneratorKindVaultDynamicSecret    GeneratorKind = "VaultDynamicSecret"
+	GeneratorKindWebhook               GeneratorKind = "Webhook"
+)
+
+// GeneratorSpec holds the spec of exactly one generator.
+// +kubebuilder:validation:MaxProperties=1
+// +kubebuilder:validation:MinProperties=1
+type GeneratorSpec struct {
+	ACRAccessTokenSpec        *ACRAccessTokenSpec        `json:"acrAccessTokenSpec,omitempty"`
+	ECRAuthorizationTokenSpec *ECRAuthorizationTokenSpec `json:"ecrAuthorizationTokenSpec,omitempty"`
+	FakeSpec                  *FakeSpec                  `json:"fakeSpec,omitempty"`
+	GCRAccessTokenSpec        *GCRAccessTokenSpec        `json:"gcrAccessTokenSpec,omitempty"`
+	GithubAccessTokenSpec     *GithubAccessTokenSpec     `json:"githubAccessTokenSpec,omitempty"`
+	JWTSpec                   *JWTSpec                   `json:"jwtSpec,omitempty"`
+	MySQLUserSpec             *MySQLUserSpec             `json:"mysqlUserSpec,omitempty"`
+	PasswordSpec              *PasswordSpec              `json:"passwordSpec,omitempty"`
+	PostgreSQLUserSpec        *PostgreSQLUserSpec        `json:"postgresqlUserSpec,omitempty"`
+	RandomValueSpec           *RandomValueSpec           `json:"randomValueSpec,omitempty"`
+	ServiceAccountTokenSpec   *ServiceAccountTokenSpec   `json:"serviceAccountTokenSpec,omitempty"`
+	SSHKeySpec                *SSHKeySpec                `json:"sshKeySpec,omitempty"`
+	STSSessionTokenSpec       *STSSessionTokenSpec       `json:"stsSessionTokenSpec,omitempty"`
+	TLSCertificateSpec        *TLSCertificateSpec        `json:"tlsCertificateSpec,omitempty"`
+	VaultDynamicSecretSpec    *VaultDynamicSecretSpec    `json:"vaultDynamicSecretSpec,omitempty"`
+	WebhookSpec               *WebhookSpec               `json:"webhookSpec,omitempty"`
+}
+
+// GetSpec returns the generator spec which matches the kind of the ClusterGenerator.
+func (s *ClusterGeneratorSpec) GetSpec() (any, error) {
+	return s.Generator.GetSpec(s.Kind)
+}
+
+// GetSpec returns the generator spec of the given kind.
+func (s *GeneratorSpec) GetSpec(kind GeneratorKind) (any, error) {
+	var spec any
+	switch kind {
+	case GeneratorKindACRAccessToken:
+		spec = s.ACRAccessTokenSpec
+	case GeneratorKindECRAuthorizationToken:
+		spec = s.ECRAuthorizationTokenSpec
+	case GeneratorKindFake:
+		spec = s.FakeSpec
+	case GeneratorKindGCRAccessToken:
+		spec = s.GCRAccessTokenSpec
+	case GeneratorKindGithubAccessToken:
+		spec = s.GithubAccessTokenSpec
+	case GeneratorKindJWT:
+		spec = s.JWTSpec
+	case GeneratorKindMySQLUser:
+		spec = s.MySQLUserSpec
+	case GeneratorKindPassword:
+		spec = s.PasswordSpec
+	case GeneratorKindPostgreSQLUser:
+		spec = s.PostgreSQLUserSpec
+	case GeneratorKindRandomValue:
+		spec = s.RandomValueSpec
+	case GeneratorKindServiceAccountToken:
+		spec = s.ServiceAccountTokenSpec
+	case GeneratorKindSSHKey:
+		spec = s.SSHKeySpec
+	case GeneratorKindSTSSessionToken:
+		spec = s.STSSessionTokenSpec
+	case GeneratorKindTLSCertificate:
+		spec = s.TLSCertificateSpec
+	case GeneratorKindVaultDynamicSecret:
+		spec = s.VaultDynamicSecretSpec
+	case GeneratorKindWebhook:
+		spec = s.WebhookSpec
+	default:
+		return nil, fmt.Errorf("unsupported generator kind: %q", kind)
+	}
+	if reflect.ValueOf(spec).IsNil() {
+		return nil, fmt.Errorf("generator spec for kind %q is missing", kind)
+	}
+	return spec, nil
+}
+
+// ClusterGenerator is a cluster-wide generator which can be referenced
+// by ExternalSecrets from any namespace allowed by its conditions.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={clustergenerator},shortName=cg
+type ClusterGenerator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterGeneratorSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterGeneratorList contains a list of ClusterGenerator resources.
+type ClusterGeneratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterGenerator `json:"items"`
+}
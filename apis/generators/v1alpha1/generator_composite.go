@@ -0,0 +1,79 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// CompositeSpec controls the behavior of the composite generator.
+type CompositeSpec struct {
+	// Generators are invoked in order. Their values are available
+	// in the template as .<name>.<key>.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Generators []CompositeGenerator `json:"generators"`
+
+	// Template renders the generated data. The keys are the keys of the
+	// generated data, the values are templates using the functions of
+	// the v2 template engine, e.g. htpasswd or bcrypt.
+	// +kubebuilder:validation:MinProperties=1
+	Template map[string]string `json:"template"`
+}
+
+// CompositeGenerator is either a reference to a generator or an inline generator spec.
+type CompositeGenerator struct {
+	// Name under which the generated values are available in the template.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// GeneratorRef references a generator in the namespace
+	// of the ExternalSecret or a ClusterGenerator.
+	// +optional
+	GeneratorRef *esv1beta1.GeneratorRef `json:"generatorRef,omitempty"`
+
+	// Kind of the inline generator.
+	// +optional
+	Kind GeneratorKind `json:"kind,omitempty"`
+
+	// Generator is the spec of the inline generator, it must match its kind.
+	// +optional
+	Generator *GeneratorSpec `json:"generator,omitempty"`
+}
+
+// Composite invokes several generators and renders their
+// values through a template.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={composite},shortName=composite
+type Composite struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CompositeSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CompositeList contains a list of Composite resources.
+type CompositeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Composite `json:"items"`
+}
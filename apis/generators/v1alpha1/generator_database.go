@@ -0,0 +1,161 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// DatabaseUserSpec contains the configuration shared by the database user generators.
+type DatabaseUserSpec struct {
+	// Host of the database server.
+	Host string `json:"host"`
+
+	// Port of the database server.
+	// Defaults to the default port of the database.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Database to connect to. It is also available as
+	// .Database in the grant statements.
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// Auth contains the credentials of the admin user,
+	// which is used to create and remove users.
+	Auth DatabaseAuth `json:"auth"`
+
+	// UsernamePrefix is prepended to the random part of generated usernames.
+	// +kubebuilder:default=es_
+	// +kubebuilder:validation:Pattern=`^[a-z_][a-z0-9_]*$`
+	// +kubebuilder:validation:MaxLength=16
+	// +optional
+	UsernamePrefix string `json:"usernamePrefix,omitempty"`
+
+	// Password configures the generated password.
+	// Defaults to 24 characters without symbols.
+	// +optional
+	Password *PasswordSpec `json:"password,omitempty"`
+
+	// Grants are SQL statements which are executed after the user was created.
+	// They are Go templates, .Username and .Database can be used to refer to the
+	// generated user and the configured database. For MySQL .UserHost is available, too.
+	// +optional
+	Grants []string `json:"grants,omitempty"`
+
+	// CleanupPolicy defines what happens to users of a previous generation
+	// once the ExternalSecret was synced with a new user.
+	// +kubebuilder:default=Drop
+	// +optional
+	CleanupPolicy DatabaseUserCleanupPolicy `json:"cleanupPolicy,omitempty"`
+}
+
+// DatabaseAuth contains the credentials of the database admin user.
+type DatabaseAuth struct {
+	// Username of the admin user.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the password of the admin user.
+	PasswordSecretRef esmeta.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// DatabaseUserCleanupPolicy defines what happens to previously generated users.
+// +kubebuilder:validation:Enum=Drop;Lock;Retain
+type DatabaseUserCleanupPolicy string
+
+const (
+	// DatabaseUserCleanupPolicyDrop removes the user.
+	DatabaseUserCleanupPolicyDrop DatabaseUserCleanupPolicy = "Drop"
+	// DatabaseUserCleanupPolicyLock keeps the user but prevents it from logging in.
+	DatabaseUserCleanupPolicyLock DatabaseUserCleanupPolicy = "Lock"
+	// DatabaseUserCleanupPolicyRetain keeps the user as it is.
+	DatabaseUserCleanupPolicyRetain DatabaseUserCleanupPolicy = "Retain"
+)
+
+// PostgreSQLUserSpec controls the behavior of the PostgreSQL user generator.
+type PostgreSQLUserSpec struct {
+	DatabaseUserSpec `json:",inline"`
+
+	// SSLMode is used to connect to the database server.
+	// +kubebuilder:default=require
+	// +kubebuilder:validation:Enum=disable;require;verify-ca;verify-full
+	// +optional
+	SSLMode string `json:"sslMode,omitempty"`
+}
+
+// PostgreSQLUser creates a PostgreSQL role with a generated password.
+// The role is removed once it is no longer used.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={postgresqluser},shortName=postgresqluser
+type PostgreSQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PostgreSQLUserSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PostgreSQLUserList contains a list of PostgreSQLUser resources.
+type PostgreSQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgreSQLUser `json:"items"`
+}
+
+// MySQLUserSpec controls the behavior of the MySQL user generator.
+type MySQLUserSpec struct {
+	DatabaseUserSpec `json:",inline"`
+
+	// UserHost is the host part of the generated account,
+	// i.e. the hosts the user may connect from.
+	// +kubebuilder:default=%
+	// +optional
+	UserHost string `json:"userHost,omitempty"`
+
+	// TLS is used to connect to the database server.
+	// skip-verify does not verify the server certificate,
+	// preferred only uses TLS if the server supports it.
+	// +kubebuilder:default=required
+	// +kubebuilder:validation:Enum=required;disabled;skip-verify;preferred
+	// +optional
+	TLS string `json:"tls,omitempty"`
+}
+
+// MySQLUser creates a MySQL user with a generated password.
+// The user is removed once it is no longer used.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={mysqluser},shortName=mysqluser
+type MySQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MySQLUserSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MySQLUserList contains a list of MySQLUser resources.
+type MySQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MySQLUser `json:"items"`
+}
@@ -0,0 +1,98 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// JWTSpec controls the behavior of the JWT generator.
+// The string claims are rendered as templates, e.g. `{{ uuidv4 }}`,
+// with the namespace of the ExternalSecret available as `.namespace`.
+type JWTSpec struct {
+	// Algorithm used to sign the token.
+	Algorithm JWTAlgorithm `json:"algorithm"`
+
+	// KeySecretRef points to the signing key. For RS256, ES256 and EdDSA
+	// it must contain a PEM encoded private key or a private JWK.
+	// For HS256 the value is used as shared secret.
+	KeySecretRef esmeta.SecretKeySelector `json:"keySecretRef"`
+
+	// KeyID is set as kid header of the token.
+	// Defaults to the kid of the JWK, if any.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// Issuer is set as iss claim.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// Subject is set as sub claim.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// Audience is set as aud claim.
+	// +optional
+	Audience []string `json:"audience,omitempty"`
+
+	// Expiration is the validity of the token, the exp claim
+	// is set relative to the time it is generated.
+	// Defaults to 1h
+	// +optional
+	Expiration *metav1.Duration `json:"expiration,omitempty"`
+
+	// Claims are custom claims of the token.
+	// The registered claims set above take precedence.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// +optional
+	Claims *apiextensions.JSON `json:"claims,omitempty"`
+}
+
+// JWTAlgorithm is the algorithm used to sign a token.
+// +kubebuilder:validation:Enum=RS256;ES256;EdDSA;HS256
+type JWTAlgorithm string
+
+const (
+	JWTAlgorithmRS256 JWTAlgorithm = "RS256"
+	JWTAlgorithmES256 JWTAlgorithm = "ES256"
+	JWTAlgorithmEdDSA JWTAlgorithm = "EdDSA"
+	JWTAlgorithmHS256 JWTAlgorithm = "HS256"
+)
+
+// JWT generates a signed JSON Web Token.
+// The token and its expiry are returned as token and expirationTimestamp.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={jwt},shortName=jwt
+type JWT struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec JWTSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JWTList contains a list of JWT resources.
+type JWTList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JWT `json:"items"`
+}
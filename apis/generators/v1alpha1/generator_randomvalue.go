@@ -0,0 +1,91 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RandomValueType is the type of a generated random value.
+// +kubebuilder:validation:Enum=UUIDv4;UUIDv7;Hex;Base64;Base64URL;Base32;Pattern
+type RandomValueType string
+
+const (
+	RandomValueTypeUUIDv4    RandomValueType = "UUIDv4"
+	RandomValueTypeUUIDv7    RandomValueType = "UUIDv7"
+	RandomValueTypeHex       RandomValueType = "Hex"
+	RandomValueTypeBase64    RandomValueType = "Base64"
+	RandomValueTypeBase64URL RandomValueType = "Base64URL"
+	RandomValueTypeBase32    RandomValueType = "Base32"
+	RandomValueTypePattern   RandomValueType = "Pattern"
+)
+
+// RandomValueSpec controls the behavior of the random value generator.
+type RandomValueSpec struct {
+	// Values to generate, each value is returned with its name as key.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Values []RandomValueItem `json:"values"`
+}
+
+// RandomValueItem configures a single generated value.
+type RandomValueItem struct {
+	// Name is the key of the value in the generated data.
+	Name string `json:"name"`
+
+	// Type of the generated value.
+	Type RandomValueType `json:"type"`
+
+	// Length is the number of random bytes of Hex, Base64, Base64URL
+	// and Base32 values. Defaults to 32.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1024
+	// +optional
+	Length int `json:"length,omitempty"`
+
+	// Pattern is the format of Pattern values, e.g. xxxx-xxxx-xxxx.
+	// Every x is replaced by a random character of the charset,
+	// a backslash escapes the following character.
+	// +optional
+	Pattern string `json:"pattern,omitempty"`
+
+	// Charset used for the placeholders of a pattern.
+	// Defaults to lowercase letters and digits.
+	// +optional
+	Charset string `json:"charset,omitempty"`
+}
+
+// RandomValue generates random values like UUIDs, encoded random
+// bytes or tokens of a given format.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={randomvalue},shortName=randomvalue
+type RandomValue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RandomValueSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RandomValueList contains a list of RandomValue resources.
+type RandomValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RandomValue `json:"items"`
+}
@@ -0,0 +1,71 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// ServiceAccountTokenSpec controls the behavior of the ServiceAccount token generator.
+type ServiceAccountTokenSpec struct {
+	// ServiceAccountRef references the ServiceAccount the token is requested for.
+	// The ServiceAccount must exist in the namespace of the ExternalSecret, or in
+	// remote.remoteNamespace if a remote cluster is configured.
+	// The audiences of the selector are set as audiences of the token.
+	ServiceAccountRef esmeta.ServiceAccountSelector `json:"serviceAccountRef"`
+
+	// ExpirationSeconds is the requested validity of the token.
+	// The API server may return a token with a different validity.
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+
+	// BoundObjectRef binds the token to the lifetime of a Pod or Secret.
+	// The token is invalidated once the object is deleted.
+	// +optional
+	BoundObjectRef *authenticationv1.BoundObjectReference `json:"boundObjectRef,omitempty"`
+
+	// Remote configures the Kubernetes cluster the token is requested from.
+	// If omitted the token is requested from the cluster the controller runs in.
+	// +optional
+	Remote *esv1beta1.KubernetesProvider `json:"remote,omitempty"`
+}
+
+// ServiceAccountToken generates a bound ServiceAccount token
+// using the TokenRequest API.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={serviceaccounttoken},shortName=serviceaccounttoken
+type ServiceAccountToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceAccountTokenSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountTokenList contains a list of ServiceAccountToken resources.
+type ServiceAccountTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountToken `json:"items"`
+}
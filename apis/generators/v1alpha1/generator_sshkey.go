@@ -0,0 +1,72 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SSHKeyType is the type of a generated SSH key pair.
+// +kubebuilder:validation:Enum=rsa;ecdsa;ed25519
+type SSHKeyType string
+
+const (
+	SSHKeyTypeRSA     SSHKeyType = "rsa"
+	SSHKeyTypeECDSA   SSHKeyType = "ecdsa"
+	SSHKeyTypeEd25519 SSHKeyType = "ed25519"
+)
+
+// SSHKeySpec controls the behavior of the SSH key generator.
+type SSHKeySpec struct {
+	// KeyType is the type of the generated key pair.
+	// Defaults to ed25519
+	// +kubebuilder:default=ed25519
+	// +optional
+	KeyType SSHKeyType `json:"keyType,omitempty"`
+
+	// Bits is the size of the key. For rsa it defaults to 4096 and must be
+	// between 2048 and 8192. For ecdsa it selects the curve: 256 (default), 384 or 521.
+	// It is ignored for ed25519.
+	// +kubebuilder:validation:Maximum=8192
+	// +optional
+	Bits int `json:"bits,omitempty"`
+
+	// Comment is added to the generated key pair, e.g. user@host.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// SSHKey generates an SSH key pair in OpenSSH format.
+// The private key is returned as id_<keyType> and the
+// public key as id_<keyType>.pub.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={sshkey},shortName=sshkey
+type SSHKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SSHKeySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SSHKeyList contains a list of SSHKey resources.
+type SSHKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SSHKey `json:"items"`
+}
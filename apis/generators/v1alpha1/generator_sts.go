@@ -0,0 +1,84 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type STSSessionTokenSpec struct {
+	// Region specifies the region to operate in.
+	Region string `json:"region"`
+
+	// Auth defines how to authenticate with AWS.
+	// These source credentials are used to request the session credentials.
+	// +optional
+	Auth AWSAuth `json:"auth,omitempty"`
+
+	// Role is assumed with AssumeRole to create the session credentials.
+	// If omitted, GetSessionToken is called with the source credentials,
+	// which must then be long-term IAM user credentials.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// RoleSessionName identifies the session of the assumed role.
+	// +kubebuilder:default=external-secrets
+	// +optional
+	RoleSessionName string `json:"roleSessionName,omitempty"`
+
+	// ExternalID is passed to AssumeRole, if the trust policy of the role requires it.
+	// +optional
+	ExternalID string `json:"externalID,omitempty"`
+
+	// SessionTags are passed to AssumeRole.
+	// +optional
+	SessionTags []AWSSessionTag `json:"sessionTags,omitempty"`
+
+	// DurationSeconds is the validity of the session credentials.
+	// Defaults to 1 hour for AssumeRole and 12 hours for GetSessionToken.
+	// The maximum is limited by the maximum session duration of the role.
+	// +kubebuilder:validation:Minimum=900
+	// +kubebuilder:validation:Maximum=129600
+	// +optional
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+}
+
+// AWSSessionTag is a tag attached to an assumed role session.
+type AWSSessionTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// STSSessionToken uses the GetSessionToken or AssumeRole API to
+// retrieve short-lived AWS credentials.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={stssessiontoken},shortName=stssessiontoken
+type STSSessionToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec STSSessionTokenSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// STSSessionTokenList contains a list of STSSessionToken resources.
+type STSSessionTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []STSSessionToken `json:"items"`
+}
@@ -0,0 +1,151 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// TLSCertificateSpec controls the behavior of the TLS certificate generator.
+type TLSCertificateSpec struct {
+	// CommonName of the certificate subject.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// Organizations of the certificate subject.
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
+
+	// DNSNames is a list of DNS subject alternative names.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// IPAddresses is a list of IP address subject alternative names.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// URIs is a list of URI subject alternative names.
+	// +optional
+	URIs []string `json:"uris,omitempty"`
+
+	// EmailAddresses is a list of email subject alternative names.
+	// +optional
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+
+	// Duration is the validity of the certificate, it must be positive.
+	// Defaults to 2160h (90 days)
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// IsCA marks the certificate as certificate authority.
+	// +optional
+	IsCA bool `json:"isCA,omitempty"`
+
+	// PrivateKey configures the private key of the certificate.
+	// +optional
+	PrivateKey TLSCertificatePrivateKey `json:"privateKey,omitempty"`
+
+	// Usages of the certificate.
+	// Defaults to digital signature, key encipherment and server auth.
+	// +optional
+	Usages []TLSCertificateKeyUsage `json:"usages,omitempty"`
+
+	// CA signs the certificate with the referenced certificate authority.
+	// If omitted, a self-signed certificate is generated.
+	// +optional
+	CA *TLSCertificateCA `json:"ca,omitempty"`
+
+	// PKCS12 additionally returns the certificate, its private key and
+	// the CA certificate as PKCS#12 keystore without password as keystore.p12.
+	// +optional
+	PKCS12 bool `json:"pkcs12,omitempty"`
+}
+
+// TLSCertificatePrivateKeyAlgorithm is the algorithm of a private key.
+// +kubebuilder:validation:Enum=RSA;ECDSA;Ed25519
+type TLSCertificatePrivateKeyAlgorithm string
+
+const (
+	TLSCertificatePrivateKeyAlgorithmRSA     TLSCertificatePrivateKeyAlgorithm = "RSA"
+	TLSCertificatePrivateKeyAlgorithmECDSA   TLSCertificatePrivateKeyAlgorithm = "ECDSA"
+	TLSCertificatePrivateKeyAlgorithmEd25519 TLSCertificatePrivateKeyAlgorithm = "Ed25519"
+)
+
+// TLSCertificatePrivateKey configures the private key of a certificate.
+type TLSCertificatePrivateKey struct {
+	// Algorithm of the private key.
+	// Defaults to ECDSA
+	// +kubebuilder:default=ECDSA
+	// +optional
+	Algorithm TLSCertificatePrivateKeyAlgorithm `json:"algorithm,omitempty"`
+
+	// Size of the private key. For RSA it defaults to 2048 and must be
+	// between 2048 and 8192. For ECDSA it selects the curve: 256 (default), 384 or 521.
+	// It is ignored for Ed25519.
+	// +kubebuilder:validation:Maximum=8192
+	// +optional
+	Size int `json:"size,omitempty"`
+}
+
+// TLSCertificateKeyUsage is a usage of a certificate.
+// +kubebuilder:validation:Enum="digital signature";"key encipherment";"data encipherment";"key agreement";"cert sign";"crl sign";"server auth";"client auth";"code signing";"email protection"
+type TLSCertificateKeyUsage string
+
+const (
+	TLSCertificateUsageDigitalSignature TLSCertificateKeyUsage = "digital signature"
+	TLSCertificateUsageKeyEncipherment  TLSCertificateKeyUsage = "key encipherment"
+	TLSCertificateUsageDataEncipherment TLSCertificateKeyUsage = "data encipherment"
+	TLSCertificateUsageKeyAgreement     TLSCertificateKeyUsage = "key agreement"
+	TLSCertificateUsageCertSign         TLSCertificateKeyUsage = "cert sign"
+	TLSCertificateUsageCRLSign          TLSCertificateKeyUsage = "crl sign"
+	TLSCertificateUsageServerAuth       TLSCertificateKeyUsage = "server auth"
+	TLSCertificateUsageClientAuth       TLSCertificateKeyUsage = "client auth"
+	TLSCertificateUsageCodeSigning      TLSCertificateKeyUsage = "code signing"
+	TLSCertificateUsageEmailProtection  TLSCertificateKeyUsage = "email protection"
+)
+
+// TLSCertificateCA references the certificate authority which signs the certificate.
+type TLSCertificateCA struct {
+	// CertSecretRef points to the PEM encoded certificate of the CA.
+	CertSecretRef esmeta.SecretKeySelector `json:"certSecretRef"`
+
+	// KeySecretRef points to the PEM encoded private key of the CA.
+	KeySecretRef esmeta.SecretKeySelector `json:"keySecretRef"`
+}
+
+// TLSCertificate generates a TLS certificate which is either self-signed
+// or signed by a CA. The certificate, its private key and the CA certificate
+// are returned as tls.crt, tls.key and ca.crt.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={tlscertificate},shortName=tlscertificate
+type TLSCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TLSCertificateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TLSCertificateList contains a list of TLSCertificate resources.
+type TLSCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TLSCertificate `json:"items"`
+}
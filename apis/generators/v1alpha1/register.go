@@ -100,6 +100,86 @@ var (
 	GithubAccessTokenGroupVersionKind = SchemeGroupVersion.WithKind(GithubAccessTokenKind)
 )
 
+// SSHKey type metadata.
+var (
+	SSHKeyKind             = reflect.TypeOf(SSHKey{}).Name()
+	SSHKeyGroupKind        = schema.GroupKind{Group: Group, Kind: SSHKeyKind}.String()
+	SSHKeyKindAPIVersion   = SSHKeyKind + "." + SchemeGroupVersion.String()
+	SSHKeyGroupVersionKind = SchemeGroupVersion.WithKind(SSHKeyKind)
+)
+
+// TLSCertificate type metadata.
+var (
+	TLSCertificateKind             = reflect.TypeOf(TLSCertificate{}).Name()
+	TLSCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: TLSCertificateKind}.String()
+	TLSCertificateKindAPIVersion   = TLSCertificateKind + "." + SchemeGroupVersion.String()
+	TLSCertificateGroupVersionKind = SchemeGroupVersion.WithKind(TLSCertificateKind)
+)
+
+// ServiceAccountToken type metadata.
+var (
+	ServiceAccountTokenKind             = reflect.TypeOf(ServiceAccountToken{}).Name()
+	ServiceAccountTokenGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceAccountTokenKind}.String()
+	ServiceAccountTokenKindAPIVersion   = ServiceAccountTokenKind + "." + SchemeGroupVersion.String()
+	ServiceAccountTokenGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountTokenKind)
+)
+
+// STSSessionToken type metadata.
+var (
+	STSSessionTokenKind             = reflect.TypeOf(STSSessionToken{}).Name()
+	STSSessionTokenGroupKind        = schema.GroupKind{Group: Group, Kind: STSSessionTokenKind}.String()
+	STSSessionTokenKindAPIVersion   = STSSessionTokenKind + "." + SchemeGroupVersion.String()
+	STSSessionTokenGroupVersionKind = SchemeGroupVersion.WithKind(STSSessionTokenKind)
+)
+
+// PostgreSQLUser type metadata.
+var (
+	PostgreSQLUserKind             = reflect.TypeOf(PostgreSQLUser{}).Name()
+	PostgreSQLUserGroupKind        = schema.GroupKind{Group: Group, Kind: PostgreSQLUserKind}.String()
+	PostgreSQLUserKindAPIVersion   = PostgreSQLUserKind + "." + SchemeGroupVersion.String()
+	PostgreSQLUserGroupVersionKind = SchemeGroupVersion.WithKind(PostgreSQLUserKind)
+)
+
+// MySQLUser type metadata.
+var (
+	MySQLUserKind             = reflect.TypeOf(MySQLUser{}).Name()
+	MySQLUserGroupKind        = schema.GroupKind{Group: Group, Kind: MySQLUserKind}.String()
+	MySQLUserKindAPIVersion   = MySQLUserKind + "." + SchemeGroupVersion.String()
+	MySQLUserGroupVersionKind = SchemeGroupVersion.WithKind(MySQLUserKind)
+)
+
+// RandomValue type metadata.
+var (
+	RandomValueKind             = reflect.TypeOf(RandomValue{}).Name()
+	RandomValueGroupKind        = schema.GroupKind{Group: Group, Kind: RandomValueKind}.String()
+	RandomValueKindAPIVersion   = RandomValueKind + "." + SchemeGroupVersion.String()
+	RandomValueGroupVersionKind = SchemeGroupVersion.WithKind(RandomValueKind)
+)
+
+// Composite type metadata.
+var (
+	CompositeKind             = reflect.TypeOf(Composite{}).Name()
+	CompositeGroupKind        = schema.GroupKind{Group: Group, Kind: CompositeKind}.String()
+	CompositeKindAPIVersion   = CompositeKind + "." + SchemeGroupVersion.String()
+	CompositeGroupVersionKind = SchemeGroupVersion.WithKind(CompositeKind)
+)
+
+// JWT type metadata.
+var (
+	JWTKind             = reflect.TypeOf(JWT{}).Name()
+	JWTGroupKind        = schema.GroupKind{Group: Group, Kind: JWTKind}.String()
+	JWTKindAPIVersion   = JWTKind + "." + SchemeGroupVersion.String()
+	JWTGroupVersionKind = SchemeGroupVersion.WithKind(JWTKind)
+)
+
+// ClusterGenerator type metadata.
+var (
+	ClusterGeneratorKind             = reflect.TypeOf(ClusterGenerator{}).Name()
+	ClusterGeneratorGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterGeneratorKind}.String()
+	ClusterGeneratorKindAPIVersion   = ClusterGeneratorKind + "." + SchemeGroupVersion.String()
+	ClusterGeneratorGroupVersionKind = SchemeGroupVersion.WithKind(ClusterGeneratorKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ECRAuthorizationToken{}, &ECRAuthorizationToken{})
 	SchemeBuilder.Register(&GCRAccessToken{}, &GCRAccessTokenList{})
@@ -109,4 +189,14 @@ func init() {
 	SchemeBuilder.Register(&VaultDynamicSecret{}, &VaultDynamicSecretList{})
 	SchemeBuilder.Register(&Password{}, &PasswordList{})
 	SchemeBuilder.Register(&Webhook{}, &WebhookList{})
+	SchemeBuilder.Register(&SSHKey{}, &SSHKeyList{})
+	SchemeBuilder.Register(&TLSCertificate{}, &TLSCertificateList{})
+	SchemeBuilder.Register(&ServiceAccountToken{}, &ServiceAccountTokenList{})
+	SchemeBuilder.Register(&STSSessionToken{}, &STSSessionTokenList{})
+	SchemeBuilder.Register(&PostgreSQLUser{}, &PostgreSQLUserList{})
+	SchemeBuilder.Register(&MySQLUser{}, &MySQLUserList{})
+	SchemeBuilder.Register(&RandomValue{}, &RandomValueList{})
+	SchemeBuilder.Register(&Composite{}, &CompositeList{})
+	SchemeBuilder.Register(&JWT{}, &JWTList{})
+	SchemeBuilder.Register(&ClusterGenerator{}, &ClusterGeneratorList{})
 }
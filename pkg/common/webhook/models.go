@@ -56,6 +56,49 @@ type Spec struct {
 	// The provider for the CA bundle to use to validate webhook server certificate.
 	// +optional
 	CAProvider *CAProvider `json:"caProvider,omitempty"`
+
+	// Request used to push a secret
+	// +optional
+	Push *Request `json:"push,omitempty"`
+
+	// Request used to delete a pushed secret
+	// +optional
+	Delete *Request `json:"delete,omitempty"`
+
+	// Request used to check whether a pushed secret exists
+	// +optional
+	Exists *Request `json:"exists,omitempty"`
+
+	// Request used to list secrets
+	// +optional
+	List *ListRequest `json:"list,omitempty"`
+}
+
+// Request is a templated http request.
+type Request struct {
+	// Webhook Method
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Webhook url to call
+	URL string `json:"url"`
+
+	// Headers
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body
+	// +optional
+	Body string `json:"body,omitempty"`
+}
+
+// ListRequest is a templated http request returning multiple secrets.
+type ListRequest struct {
+	Request `json:",inline"`
+
+	// Result formatting
+	// +optional
+	Result Result `json:"result,omitempty"`
 }
 type CAProviderType string
 
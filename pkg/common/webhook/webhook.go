@@ -57,6 +57,9 @@ func (w *Webhook) getStoreSecret(ctx context.Context, ref SecretKeySelector) (*c
 			return nil, fmt.Errorf("no namespace on ClusterScoped webhook secret %s", ref.Name)
 		}
 		ke.Namespace = *ref.Namespace
+	} else if w.StoreKind == esv1beta1.ClusterSecretStoreKind && ref.Namespace != nil {
+		// generators wrapped by a ClusterGenerator may reference secrets in other namespaces.
+		ke.Namespace = *ref.Namespace
 	}
 	secret := &corev1.Secret{}
 	if err := w.Kube.Get(ctx, ke, secret); err != nil {
@@ -125,19 +128,52 @@ func (w *Webhook) GetTemplateData(ctx context.Context, ref *esv1beta1.ExternalSe
 			"property": url.QueryEscape(ref.Property),
 		}
 	}
+	return data, w.addSecretsTemplateData(ctx, data, secrets)
+}
+
+// GetPushTemplateData returns the template data for push, delete and exists requests.
+func (w *Webhook) GetPushTemplateData(ctx context.Context, ref esv1beta1.PushSecretRemoteRef, secrets []Secret) (map[string]map[string]string, error) {
+	data := map[string]map[string]string{
+		"remoteRef": {
+			"key":      url.QueryEscape(ref.GetRemoteKey()),
+			"property": url.QueryEscape(ref.GetProperty()),
+		},
+	}
+	return data, w.addSecretsTemplateData(ctx, data, secrets)
+}
+
+// GetFindTemplateData returns the template data for list requests.
+func (w *Webhook) GetFindTemplateData(ctx context.Context, ref esv1beta1.ExternalSecretFind, secrets []Secret) (map[string]map[string]string, error) {
+	data := map[string]map[string]string{
+		"find": {},
+		"tags": {},
+	}
+	if ref.Path != nil {
+		data["find"]["path"] = url.QueryEscape(*ref.Path)
+	}
+	if ref.Name != nil {
+		data["find"]["name"] = url.QueryEscape(ref.Name.RegExp)
+	}
+	for tKey, tVal := range ref.Tags {
+		data["tags"][tKey] = url.QueryEscape(tVal)
+	}
+	return data, w.addSecretsTemplateData(ctx, data, secrets)
+}
+
+func (w *Webhook) addSecretsTemplateData(ctx context.Context, data map[string]map[string]string, secrets []Secret) error {
 	for _, secref := range secrets {
 		if _, ok := data[secref.Name]; !ok {
 			data[secref.Name] = make(map[string]string)
 		}
 		secret, err := w.getStoreSecret(ctx, secref.SecretRef)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		for sKey, sVal := range secret.Data {
 			data[secref.Name][sKey] = string(sVal)
 		}
 	}
-	return data, nil
+	return nil
 }
 
 func (w *Webhook) GetWebhookData(ctx context.Context, provider *Spec, ref *esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
@@ -148,15 +184,29 @@ func (w *Webhook) GetWebhookData(ctx context.Context, provider *Spec, ref *esv1b
 	if err != nil {
 		return nil, err
 	}
-	method := provider.Method
+	return w.DoRequest(ctx, &Request{
+		Method:  provider.Method,
+		URL:     provider.URL,
+		Headers: provider.Headers,
+		Body:    provider.Body,
+	}, http.MethodGet, data)
+}
+
+// DoRequest renders the request templates with data and calls the endpoint.
+// defaultMethod is used if the request does not specify a method.
+func (w *Webhook) DoRequest(ctx context.Context, request *Request, defaultMethod string, data map[string]map[string]string) ([]byte, error) {
+	if w.HTTP == nil {
+		return nil, fmt.Errorf("http client not initialized")
+	}
+	method := request.Method
 	if method == "" {
-		method = http.MethodGet
+		method = defaultMethod
 	}
-	url, err := ExecuteTemplateString(provider.URL, data)
+	url, err := ExecuteTemplateString(request.URL, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse url: %w", err)
 	}
-	body, err := ExecuteTemplate(provider.Body, data)
+	body, err := ExecuteTemplate(request.Body, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse body: %w", err)
 	}
@@ -165,7 +215,7 @@ func (w *Webhook) GetWebhookData(ctx context.Context, provider *Spec, ref *esv1b
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	for hKey, hValueTpl := range provider.Headers {
+	for hKey, hValueTpl := range request.Headers {
 		hValue, err := ExecuteTemplateString(hValueTpl, data)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse header %s: %w", hKey, err)
@@ -94,6 +94,10 @@ const (
 	CallAKEYLESSSMGetRotatedSecretValue = "GetRotatedSecretValue"
 	CallAKEYLESSSMGetCertificateValue   = "GetCertificateValue"
 	CallAKEYLESSSMGetDynamicSecretValue = "GetDynamicSecretsValue"
+	CallAKEYLESSSMCreateSecret          = "CreateSecret"
+	CallAKEYLESSSMUpdateSecretVal       = "UpdateSecretVal"
+	CallAKEYLESSSMUpdateItem            = "UpdateItem"
+	CallAKEYLESSSMDeleteItem            = "DeleteItem"
 
 	StatusError   = "error"
 	StatusSuccess = "success"
@@ -36,11 +36,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	// Metrics.
 	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret/esmetrics"
 	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
+	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
+	"github.com/external-secrets/external-secrets/pkg/controllers/templating"
 	// Loading registered generators.
 	_ "github.com/external-secrets/external-secrets/pkg/generator/register"
 	// Loading registered providers.
@@ -49,29 +53,43 @@ import (
 )
 
 const (
-	fieldOwnerTemplate      = "externalsecrets.external-secrets.io/%v"
-	errGetES                = "could not get ExternalSecret"
-	errConvert              = "could not apply conversion strategy to keys: %v"
-	errDecode               = "could not apply decoding strategy to %v[%d]: %v"
-	errGenerate             = "could not generate [%d]: %w"
-	errRewrite              = "could not rewrite spec.dataFrom[%d]: %v"
-	errInvalidKeys          = "secret keys from spec.dataFrom.%v[%d] can only have alphanumeric,'-', '_' or '.' characters. Convert them using rewrite (https://external-secrets.io/latest/guides-datafrom-rewrite)"
-	errUpdateSecret         = "could not update Secret"
-	errPatchStatus          = "unable to patch status"
-	errGetExistingSecret    = "could not get existing secret: %w"
-	errSetCtrlReference     = "could not set ExternalSecret controller reference: %w"
-	errFetchTplFrom         = "error fetching templateFrom data: %w"
-	errGetSecretData        = "could not get secret data from provider"
-	errDeleteSecret         = "could not delete secret"
-	errApplyTemplate        = "could not apply template: %w"
-	errExecTpl              = "could not execute template: %w"
-	errInvalidCreatePolicy  = "invalid creationPolicy=%s. Can not delete secret i do not own"
-	errPolicyMergeNotFound  = "the desired secret %s was not found. With creationPolicy=Merge the secret won't be created"
-	errPolicyMergeGetSecret = "unable to get secret %s: %w"
-	errPolicyMergeMutate    = "unable to mutate secret %s: %w"
-	errPolicyMergePatch     = "unable to patch secret %s: %w"
+	externalSecretFinalizer     = "externalsecret.externalsecrets.io/finalizer"
+	cleanupOnDeleteTimeout      = 10 * time.Minute
+	fieldOwnerTemplate          = "externalsecrets.external-secrets.io/%v"
+	errGetES                    = "could not get ExternalSecret"
+	errConvert                  = "could not apply conversion strategy to keys: %v"
+	errDecode                   = "could not apply decoding strategy to %v[%d]: %v"
+	errGenerate                 = "could not generate [%d]: %w"
+	errCleanupGenerator         = "could not clean up generated values of [%d]: %v"
+	errCleanupOnDelete          = "could not clean up the generated values of %d dataFrom entries"
+	errCleanupAbandoned         = "giving up cleaning up the generated values of %d dataFrom entries after %v, they have to be cleaned up manually"
+	errMissingGeneratorResource = "generator state has no generator definition"
+	errUpdateFinalizer          = "could not update finalizers: %w"
+	errRewrite                  = "could not rewrite spec.dataFrom[%d]: %v"
+	errGetSecretMetadata        = "could not get secret metadata of .data[%d] from provider: %v"
+	errRewriteMetadata          = "spec.dataFrom[%d].rewrite uses secret metadata which the provider does not return: .path is the key, .tags and .version are empty"
+	errInvalidKeys              = "secret keys from spec.dataFrom.%v[%d] can only have alphanumeric,'-', '_' or '.' characters. Convert them using rewrite (https://external-secrets.io/latest/guides-datafrom-rewrite)"
+	errUpdateSecret             = "could not update Secret"
+	errPatchStatus              = "unable to patch status"
+	errGetExistingSecret        = "could not get existing secret: %w"
+	errSetCtrlReference         = "could not set ExternalSecret controller reference: %w"
+	errFetchTplFrom             = "error fetching templateFrom data: %w"
+	errGetSecretData            = "could not get secret data from provider"
+	errDeleteSecret             = "could not delete secret"
+	errApplyTemplate            = "could not apply template: %w"
+	errExecTpl                  = "could not execute template: %w"
+	errFetchSecretTpl           = "error fetching secret templates: %w"
+	errListDependents           = "could not list ExternalSecrets of template dependency"
+	errInvalidCreatePolicy      = "invalid creationPolicy=%s. Can not delete secret i do not own"
+	errPolicyMergeNotFound      = "the desired secret %s was not found. With creationPolicy=Merge the secret won't be created"
+	errPolicyMergeGetSecret     = "unable to get secret %s: %w"
+	errPolicyMergeMutate        = "unable to mutate secret %s: %w"
+	errPolicyMergePatch         = "unable to patch secret %s: %w"
 )
 
+// indexTemplateDependencies indexes ExternalSecrets by the objects their templates are read from.
+const indexTemplateDependencies = "spec.target.template.dependencies"
+
 // Reconciler reconciles a ExternalSecret object.
 type Reconciler struct {
 	client.Client
@@ -81,8 +99,13 @@ type Reconciler struct {
 	ControllerClass           string
 	RequeueInterval           time.Duration
 	ClusterSecretStoreEnabled bool
-	EnableFloodGate           bool
-	recorder                  record.EventRecorder
+	// ClusterSecretTemplateEnabled allows templates to reference ClusterSecretTemplates.
+	ClusterSecretTemplateEnabled bool
+	EnableFloodGate              bool
+	recorder                     record.EventRecorder
+	// cache reads the versions of template dependencies. Secrets and ConfigMaps
+	// may be excluded from the client cache, the manager cache holds their metadata.
+	cache client.Reader
 }
 
 // Reconcile implements the main reconciliation loop
@@ -129,12 +152,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		timeSinceLastRefresh = time.Since(externalSecret.Status.RefreshTime.Time)
 	}
 
-	// skip reconciliation if deletion timestamp is set on external secret
-	if externalSecret.DeletionTimestamp != nil {
-		log.Info("skipping as it is in deletion")
-		return ctrl.Result{}, nil
-	}
-
 	// if extended metrics is enabled, refine the time series vector
 	resourceLabels = ctrlmetrics.RefineLabels(resourceLabels, externalSecret.Labels)
 
@@ -150,6 +167,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	// skip reconciliation if deletion timestamp is set on external secret
+	if externalSecret.DeletionTimestamp != nil {
+		return r.handleDeletion(ctx, &externalSecret, log)
+	}
+
+	// retry to clean up the values which could not be cleaned up before.
+	if err := r.cleanupStaleGeneratorStates(ctx, &externalSecret); err != nil {
+		log.Error(err, errPatchStatus)
+	}
+
 	refreshInt := r.RequeueInterval
 	if externalSecret.Spec.RefreshInterval != nil {
 		refreshInt = externalSecret.Spec.RefreshInterval.Duration
@@ -176,8 +203,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// 1. resource generation hasn't changed
 	// 2. refresh interval is 0
 	// 3. if we're still within refresh-interval
-	if !shouldRefresh(externalSecret) && isSecretValid(existingSecret) {
+	// 4. the secret templates haven't changed
+	templateVersion := r.getTemplateVersion(ctx, &externalSecret)
+	if !shouldRefresh(externalSecret) && isSecretValid(existingSecret) && externalSecret.Status.SyncedTemplateVersion == templateVersion {
 		refreshInt = (externalSecret.Spec.RefreshInterval.Duration - timeSinceLastRefresh) + 5*time.Second
+		refreshInt = refreshBeforeExpiration(refreshInt, externalSecret)
 		log.V(1).Info("skipping refresh", "rv", getResourceVersion(externalSecret), "nr", refreshInt.Seconds())
 		return ctrl.Result{RequeueAfter: refreshInt}, nil
 	}
@@ -186,12 +216,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	// the finalizer is added before values are generated, so they are cleaned up on deletion.
+	if usesGenerators(externalSecret) && !controllerutil.ContainsFinalizer(&externalSecret, externalSecretFinalizer) {
+		controllerutil.AddFinalizer(&externalSecret, externalSecretFinalizer)
+		if err := r.Update(ctx, &externalSecret); err != nil {
+			return ctrl.Result{}, fmt.Errorf(errUpdateFinalizer, err)
+		}
+	}
+
 	// patch status when done processing
 	p := client.MergeFrom(externalSecret.DeepCopy())
 	defer func() {
 		err = r.Status().Patch(ctx, &externalSecret, p)
 		if err != nil {
 			log.Error(err, errPatchStatus)
+			return
+		}
+		// values which are not in use anymore are cleaned up once their states have been persisted.
+		if err := r.cleanupStaleGeneratorStates(ctx, &externalSecret); err != nil {
+			log.Error(err, errPatchStatus)
 		}
 	}()
 
@@ -204,12 +247,32 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		Data:      make(map[string][]byte),
 	}
 
-	dataMap, err := r.getProviderSecretData(ctx, &externalSecret)
+	// We MUST NOT create multiple instances of a provider client (mostly due to limitations with GCP)
+	// Clientmanager keeps track of the client instances
+	// that are created during the fetching process and closes clients
+	// if needed.
+	mgr := secretstore.NewManager(r.Client, r.ControllerClass, r.EnableFloodGate)
+	dataMap, metadataMap, generatorStates, err := r.getProviderSecretData(ctx, mgr, &externalSecret)
+	expiration := mgr.Expiration()
+	mgr.Close(ctx)
 	if err != nil {
+		discardGeneratorStates(&externalSecret, generatorStates)
 		r.markAsFailed(log, errGetSecretData, err, &externalSecret, syncCallsError.With(resourceLabels))
 		return ctrl.Result{}, err
 	}
 
+	// once the secret is synced, the values generated during the previous sync are cleaned up.
+	// Values generated during this sync are cleaned up right away if the secret could not be synced.
+	// This runs before the status is patched, the cleanup itself happens afterwards.
+	synced := false
+	defer func() {
+		if synced {
+			rotateGeneratorStates(&externalSecret, generatorStates)
+		} else {
+			discardGeneratorStates(&externalSecret, generatorStates)
+		}
+	}()
+
 	// if no data was found we can delete the secret if needed.
 	if len(dataMap) == 0 {
 		switch externalSecret.Spec.Target.DeletionPolicy {
@@ -230,11 +293,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 			conditionSynced := NewExternalSecretCondition(esv1beta1.ExternalSecretReady, v1.ConditionTrue, esv1beta1.ConditionReasonSecretDeleted, "secret deleted due to DeletionPolicy")
 			SetExternalSecretCondition(&externalSecret, *conditionSynced)
+			synced = true
 			return ctrl.Result{RequeueAfter: refreshInt}, nil
 		// In case provider secrets don't exist the kubernetes secret will be kept as-is.
 		case esv1beta1.DeletionPolicyRetain:
-			r.markAsDone(&externalSecret, start, log)
-			return ctrl.Result{RequeueAfter: refreshInt}, nil
+			r.markAsDone(&externalSecret, templateVersion, expiration, start, log)
+			synced = true
+			return ctrl.Result{RequeueAfter: refreshBeforeExpiration(refreshInt, externalSecret)}, nil
 		// noop, handled below
 		case esv1beta1.DeletionPolicyMerge:
 		}
@@ -263,7 +328,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				delete(secret.Data, key)
 			}
 		}
-		err = r.applyTemplate(ctx, &externalSecret, secret, dataMap)
+		err = r.applyTemplate(ctx, &externalSecret, secret, &existingSecret, dataMap, metadataMap)
 		if err != nil {
 			return fmt.Errorf(errApplyTemplate, err)
 		}
@@ -308,20 +373,74 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	r.markAsDone(&externalSecret, start, log)
+	r.markAsDone(&externalSecret, templateVersion, expiration, start, log)
+	synced = true
 
 	return ctrl.Result{
-		RequeueAfter: refreshInt,
+		RequeueAfter: refreshBeforeExpiration(refreshInt, externalSecret),
 	}, nil
 }
 
-func (r *Reconciler) markAsDone(externalSecret *esv1beta1.ExternalSecret, start time.Time, log logr.Logger) {
+// handleDeletion cleans up all values generated for the ExternalSecret
+// and removes the finalizer once they have been cleaned up.
+// With the Orphan creation policy the target secret outlives the ExternalSecret,
+// so only the stale values are cleaned up and the values in use are kept.
+// If the cleanup still fails after cleanupOnDeleteTimeout, the values are left
+// behind and the finalizer is removed anyway, so the deletion is not blocked forever.
+func (r *Reconciler) handleDeletion(ctx context.Context, externalSecret *esv1beta1.ExternalSecret, log logr.Logger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(externalSecret, externalSecretFinalizer) {
+		log.Info("skipping as it is in deletion")
+		return ctrl.Result{}, nil
+	}
+	states := make([]esv1beta1.ExternalSecretGeneratorState, 0, len(externalSecret.Status.GeneratorStates)+len(externalSecret.Status.StaleGeneratorStates))
+	if externalSecret.Spec.Target.CreationPolicy != esv1beta1.CreatePolicyOrphan {
+		states = append(states, externalSecret.Status.GeneratorStates...)
+	}
+	states = append(states, externalSecret.Status.StaleGeneratorStates...)
+	failed := r.cleanupGeneratorStates(ctx, externalSecret, states)
+	if len(failed) > 0 {
+		if time.Since(externalSecret.DeletionTimestamp.Time) < cleanupOnDeleteTimeout {
+			p := client.MergeFrom(externalSecret.DeepCopy())
+			externalSecret.Status.GeneratorStates = nil
+			externalSecret.Status.StaleGeneratorStates = failed
+			if err := r.Status().Patch(ctx, externalSecret, p); err != nil {
+				log.Error(err, errPatchStatus)
+			}
+			return ctrl.Result{}, fmt.Errorf(errCleanupOnDelete, len(failed))
+		}
+		msg := fmt.Sprintf(errCleanupAbandoned, len(failed), cleanupOnDeleteTimeout)
+		log.Info(msg)
+		r.recorder.Event(externalSecret, v1.EventTypeWarning, esv1beta1.ReasonCleanupFailed, msg)
+	}
+	controllerutil.RemoveFinalizer(externalSecret, externalSecretFinalizer)
+	if err := r.Update(ctx, externalSecret); err != nil {
+		return ctrl.Result{}, fmt.Errorf(errUpdateFinalizer, err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// usesGenerators returns true if any of the dataFrom entries references a generator.
+func usesGenerators(es esv1beta1.ExternalSecret) bool {
+	for _, ref := range es.Spec.DataFrom {
+		if ref.SourceRef != nil && ref.SourceRef.GeneratorRef != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reconciler) markAsDone(externalSecret *esv1beta1.ExternalSecret, templateVersion string, expiration, start time.Time, log logr.Logger) {
 	r.recorder.Event(externalSecret, v1.EventTypeNormal, esv1beta1.ReasonUpdated, "Updated Secret")
 	conditionSynced := NewExternalSecretCondition(esv1beta1.ExternalSecretReady, v1.ConditionTrue, esv1beta1.ConditionReasonSecretSynced, "Secret was synced")
 	currCond := GetExternalSecretCondition(externalSecret.Status, esv1beta1.ExternalSecretReady)
 	SetExternalSecretCondition(externalSecret, *conditionSynced)
 	externalSecret.Status.RefreshTime = metav1.NewTime(start)
 	externalSecret.Status.SyncedResourceVersion = getResourceVersion(*externalSecret)
+	externalSecret.Status.SyncedTemplateVersion = templateVersion
+	externalSecret.Status.ExpirationTime = nil
+	if !expiration.IsZero() {
+		externalSecret.Status.ExpirationTime = &metav1.Time{Time: expiration}
+	}
 	if currCond == nil || currCond.Status != conditionSynced.Status {
 		log.Info("reconciled secret") // Log once if on success in any verbosity
 	} else {
@@ -578,9 +697,42 @@ func shouldRefresh(es esv1beta1.ExternalSecret) bool {
 	if es.Status.RefreshTime.IsZero() {
 		return true
 	}
+	if refreshTime := expirationRefreshTime(es); !refreshTime.IsZero() && refreshTime.Before(time.Now()) {
+		return true
+	}
 	return es.Status.RefreshTime.Add(es.Spec.RefreshInterval.Duration).Before(time.Now())
 }
 
+// expirationRefreshTime returns the time the synced values are refreshed at
+// so they are replaced before they expire, which is after 80% of their lifetime.
+// It returns the zero time if the values do not expire.
+func expirationRefreshTime(es esv1beta1.ExternalSecret) time.Time {
+	if es.Status.ExpirationTime == nil {
+		return time.Time{}
+	}
+	lifetime := es.Status.ExpirationTime.Sub(es.Status.RefreshTime.Time)
+	return es.Status.RefreshTime.Add(lifetime * 4 / 5)
+}
+
+// refreshBeforeExpiration shortens the refresh interval if the synced values
+// expire before the next refresh. A refresh interval of 0 is kept as is.
+func refreshBeforeExpiration(refreshInt time.Duration, es esv1beta1.ExternalSecret) time.Duration {
+	refreshTime := expirationRefreshTime(es)
+	if refreshInt <= 0 || refreshTime.IsZero() {
+		return refreshInt
+	}
+	untilRefresh := time.Until(refreshTime)
+	if untilRefresh < 0 {
+		untilRefresh = 0
+	}
+	// requeue a bit after the refresh time, so shouldRefresh has passed it.
+	untilRefresh += time.Second
+	if untilRefresh < refreshInt {
+		return untilRefresh
+	}
+	return refreshInt
+}
+
 func shouldReconcile(es esv1beta1.ExternalSecret) bool {
 	if es.Spec.Target.Immutable && hasSyncedCondition(es) {
 		return false
@@ -626,10 +778,65 @@ func (r *Reconciler) computeDataHashAnnotation(existing, secret *v1.Secret) stri
 // SetupWithManager returns a new controller builder that will be started by the provided Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
 	r.recorder = mgr.GetEventRecorderFor("external-secrets")
+	r.cache = mgr.GetCache()
+
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &esv1beta1.ExternalSecret{}, indexTemplateDependencies, func(obj client.Object) []string {
+		es := obj.(*esv1beta1.ExternalSecret)
+		deps := templating.Dependencies(es.Namespace, es.Spec.Target.Template)
+		keys := make([]string, len(deps))
+		for i := range deps {
+			keys[i] = deps[i].String()
+		}
+		return keys
+	})
+	if err != nil {
+		return err
+	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(opts).
 		For(&esv1beta1.ExternalSecret{}).
 		Owns(&v1.Secret{}, builder.OnlyMetadata).
-		Complete(r)
+		Watches(
+			&v1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(mgr.GetCache(), templating.KindConfigMap)),
+			builder.OnlyMetadata,
+		).
+		Watches(
+			&v1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(mgr.GetCache(), templating.KindSecret)),
+			builder.OnlyMetadata,
+		).
+		Watches(
+			&esv1beta1.SecretTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(mgr.GetCache(), esv1beta1.SecretTemplateKind)),
+		)
+	// cluster-scoped resources can not be watched with namespace-scoped RBAC.
+	if r.ClusterSecretTemplateEnabled {
+		b = b.Watches(
+			&esv1beta1.ClusterSecretTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDependency(mgr.GetCache(), esv1beta1.ClusterSecretTemplateKind)),
+		)
+	}
+	return b.Complete(r)
+}
+
+// findObjectsForDependency returns the ExternalSecrets whose templates
+// are read from an object of the given kind.
+// The reader must provide the dependency index, i.e. the manager cache.
+func (r *Reconciler) findObjectsForDependency(reader client.Reader, kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		dep := templating.Dependency{Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		var externalSecrets esv1beta1.ExternalSecretList
+		err := reader.List(ctx, &externalSecrets, client.MatchingFields{indexTemplateDependencies: dep.String()})
+		if err != nil {
+			r.Log.Error(err, errListDependents, "dependency", dep.String())
+			return nil
+		}
+		requests := make([]reconcile.Request, len(externalSecrets.Items))
+		for i := range externalSecrets.Items {
+			requests[i] = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&externalSecrets.Items[i])}
+		}
+		return requests
+	}
 }
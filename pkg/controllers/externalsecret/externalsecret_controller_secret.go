@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 
 	v1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
@@ -34,20 +36,20 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
 	// Loading registered generators.
 	_ "github.com/external-secrets/external-secrets/pkg/generator/register"
+	"github.com/external-secrets/external-secrets/pkg/generator/resolver"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/register"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
 // getProviderSecretData returns the provider's secret data with the provided ExternalSecret.
-func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *esv1beta1.ExternalSecret) (map[string][]byte, error) {
-	// We MUST NOT create multiple instances of a provider client (mostly due to limitations with GCP)
-	// Clientmanager keeps track of the client instances
-	// that are created during the fetching process and closes clients
-	// if needed.
-	mgr := secretstore.NewManager(r.Client, r.ControllerClass, r.EnableFloodGate)
-	defer mgr.Close(ctx)
-
+// It also returns the metadata of the secrets of .data that are fetched with MetadataPolicy=Fetch
+// and the states of the generators which were used to generate values.
+// The states are returned even if an error occurs, so the caller is able to clean them up.
+// The provider clients are created with the given manager, which has to be closed by the caller.
+func (r *Reconciler) getProviderSecretData(ctx context.Context, mgr *secretstore.Manager, externalSecret *esv1beta1.ExternalSecret) (map[string][]byte, map[string]esv1beta1.SecretMetadata, []esv1beta1.ExternalSecretGeneratorState, error) {
 	providerData := make(map[string][]byte)
+	providerMetadata := make(map[string]esv1beta1.SecretMetadata)
+	var generatorStates []esv1beta1.ExternalSecretGeneratorState
 	for i, remoteRef := range externalSecret.Spec.DataFrom {
 		var secretMap map[string][]byte
 		var err error
@@ -57,7 +59,11 @@ func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *
 		} else if remoteRef.Extract != nil {
 			secretMap, err = r.handleExtractSecrets(ctx, externalSecret, remoteRef, mgr, i)
 		} else if remoteRef.SourceRef != nil && remoteRef.SourceRef.GeneratorRef != nil {
-			secretMap, err = r.handleGenerateSecrets(ctx, externalSecret.Namespace, remoteRef, i)
+			var state *esv1beta1.ExternalSecretGeneratorState
+			secretMap, state, err = r.handleGenerateSecrets(ctx, externalSecret.Namespace, remoteRef, i)
+			if state != nil {
+				generatorStates = append(generatorStates, *state)
+			}
 		}
 		if errors.Is(err, esv1beta1.NoSecretErr) && externalSecret.Spec.Target.DeletionPolicy != esv1beta1.DeletionPolicyRetain {
 			r.recorder.Event(
@@ -69,26 +75,26 @@ func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *
 			continue
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, generatorStates, err
 		}
 		providerData = utils.MergeByteMap(providerData, secretMap)
 	}
 
 	for i, secretRef := range externalSecret.Spec.Data {
-		err := r.handleSecretData(ctx, i, *externalSecret, secretRef, providerData, mgr)
+		err := r.handleSecretData(ctx, i, *externalSecret, secretRef, providerData, providerMetadata, mgr)
 		if errors.Is(err, esv1beta1.NoSecretErr) && externalSecret.Spec.Target.DeletionPolicy != esv1beta1.DeletionPolicyRetain {
 			r.recorder.Event(externalSecret, v1.EventTypeNormal, esv1beta1.ReasonDeleted, fmt.Sprintf("secret does not exist at provider using .data[%d] key=%s", i, secretRef.RemoteRef.Key))
 			continue
 		}
 		if err != nil {
-			return nil, fmt.Errorf("error retrieving secret at .data[%d], key: %s, err: %w", i, secretRef.RemoteRef.Key, err)
+			return nil, nil, generatorStates, fmt.Errorf("error retrieving secret at .data[%d], key: %s, err: %w", i, secretRef.RemoteRef.Key, err)
 		}
 	}
 
-	return providerData, nil
+	return providerData, providerMetadata, generatorStates, nil
 }
 
-func (r *Reconciler) handleSecretData(ctx context.Context, i int, externalSecret esv1beta1.ExternalSecret, secretRef esv1beta1.ExternalSecretData, providerData map[string][]byte, cmgr *secretstore.Manager) error {
+func (r *Reconciler) handleSecretData(ctx context.Context, i int, externalSecret esv1beta1.ExternalSecret, secretRef esv1beta1.ExternalSecretData, providerData map[string][]byte, providerMetadata map[string]esv1beta1.SecretMetadata, cmgr *secretstore.Manager) error {
 	client, err := cmgr.Get(ctx, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, toStoreGenSourceRef(secretRef.SourceRef))
 	if err != nil {
 		return err
@@ -102,6 +108,20 @@ func (r *Reconciler) handleSecretData(ctx context.Context, i int, externalSecret
 		return fmt.Errorf(errDecode, "spec.data", i, err)
 	}
 	providerData[secretRef.SecretKey] = secretData
+
+	// the metadata is exposed to templates if requested and the provider returns it.
+	tpl := externalSecret.Spec.Target.Template
+	metadataClient, ok := client.(esv1beta1.SecretsMetadataClient)
+	if !ok || tpl == nil || !tpl.FetchRemoteMetadata {
+		return nil
+	}
+	metadata, err := metadataClient.GetSecretMetadata(ctx, secretRef.RemoteRef)
+	if err != nil {
+		// the metadata is not required to sync the value, templates see empty metadata instead.
+		r.recorder.Event(&externalSecret, v1.EventTypeWarning, esv1beta1.ReasonMetadataFailed, fmt.Sprintf(errGetSecretMetadata, i, err))
+		return nil
+	}
+	providerMetadata[secretRef.SecretKey] = metadata
 	return nil
 }
 
@@ -114,32 +134,130 @@ func toStoreGenSourceRef(ref *esv1beta1.StoreSourceRef) *esv1beta1.StoreGenerato
 	}
 }
 
-func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string, remoteRef esv1beta1.ExternalSecretDataFromRemoteRef, i int) (map[string][]byte, error) {
+// handleGenerateSecrets generates the values of .dataFrom[i].
+// The state returned by the generator is returned even if the values
+// could not be processed, so the caller is able to clean it up.
+func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string, remoteRef esv1beta1.ExternalSecretDataFromRemoteRef, i int) (map[string][]byte, *esv1beta1.ExternalSecretGeneratorState, error) {
 	genDef, err := r.getGeneratorDefinition(ctx, namespace, remoteRef.SourceRef.GeneratorRef)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	gen, err := genv1alpha1.GetGenerator(genDef)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	secretMap, genState, err := gen.Generate(resolver.Context(ctx, remoteRef.SourceRef.GeneratorRef), genDef, r.Client, namespace)
+	var state *esv1beta1.ExternalSecretGeneratorState
+	if genState != nil {
+		resource, resErr := generatorResource(genDef)
+		if resErr != nil {
+			return nil, nil, errors.Join(err, resErr)
+		}
+		state = &esv1beta1.ExternalSecretGeneratorState{
+			DataFromIndex: i,
+			GeneratorRef:  *remoteRef.SourceRef.GeneratorRef,
+			State:         genState,
+			Resource:      resource,
+		}
 	}
-	secretMap, err := gen.Generate(ctx, genDef, r.Client, namespace)
 	if err != nil {
-		return nil, fmt.Errorf(errGenerate, i, err)
+		return nil, state, fmt.Errorf(errGenerate, i, err)
 	}
 	secretMap, err = utils.RewriteMap(remoteRef.Rewrite, secretMap)
 	if err != nil {
-		return nil, fmt.Errorf(errRewrite, i, err)
+		return nil, state, fmt.Errorf(errRewrite, i, err)
 	}
 	if !utils.ValidateKeys(secretMap) {
-		return nil, fmt.Errorf(errInvalidKeys, "generator", i)
+		return nil, state, fmt.Errorf(errInvalidKeys, "generator", i)
 	}
-	return secretMap, err
+	return secretMap, state, err
+}
+
+// generatorResource returns the parts of the generator definition which are needed
+// to clean up the generated values: apiVersion, kind, name, namespace and spec.
+func generatorResource(genDef *apiextensions.JSON) (*apiextensions.JSON, error) {
+	var res struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+		Metadata   struct {
+			Name      string `json:"name,omitempty"`
+			Namespace string `json:"namespace,omitempty"`
+		} `json:"metadata"`
+		Spec json.RawMessage `json:"spec,omitempty"`
+	}
+	if err := json.Unmarshal(genDef.Raw, &res); err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return &apiextensions.JSON{Raw: raw}, nil
+}
+
+// rotateGeneratorStates keeps track of the states of the values which are in use now.
+// The values generated during the previous sync are marked as stale to be cleaned up.
+func rotateGeneratorStates(externalSecret *esv1beta1.ExternalSecret, states []esv1beta1.ExternalSecretGeneratorState) {
+	discardGeneratorStates(externalSecret, externalSecret.Status.GeneratorStates)
+	externalSecret.Status.GeneratorStates = states
+}
+
+// discardGeneratorStates marks the given states as stale, e.g. because the values
+// generated alongside them could not be synced.
+func discardGeneratorStates(externalSecret *esv1beta1.ExternalSecret, states []esv1beta1.ExternalSecretGeneratorState) {
+	externalSecret.Status.StaleGeneratorStates = append(externalSecret.Status.StaleGeneratorStates, states...)
+}
+
+// cleanupStaleGeneratorStates lets the generators release the values belonging to the stale states
+// and patches the status. It must only be called once the stale states have been persisted,
+// otherwise states which fail to clean up would be lost.
+// States which could not be cleaned up are kept in the status and retried during the next reconcile.
+// Failures are reported as events but do not fail the reconciliation.
+func (r *Reconciler) cleanupStaleGeneratorStates(ctx context.Context, externalSecret *esv1beta1.ExternalSecret) error {
+	if len(externalSecret.Status.StaleGeneratorStates) == 0 {
+		return nil
+	}
+	p := client.MergeFrom(externalSecret.DeepCopy())
+	externalSecret.Status.StaleGeneratorStates = r.cleanupGeneratorStates(ctx, externalSecret, externalSecret.Status.StaleGeneratorStates)
+	return r.Status().Patch(ctx, externalSecret, p)
+}
+
+// cleanupGeneratorStates lets the generators release the values belonging to the given states.
+// It returns the states which could not be cleaned up.
+func (r *Reconciler) cleanupGeneratorStates(ctx context.Context, externalSecret *esv1beta1.ExternalSecret, states []esv1beta1.ExternalSecretGeneratorState) []esv1beta1.ExternalSecretGeneratorState {
+	var failed []esv1beta1.ExternalSecretGeneratorState
+	for _, state := range states {
+		err := r.cleanupGeneratorState(ctx, externalSecret.Namespace, state)
+		if err != nil {
+			msg := fmt.Sprintf(errCleanupGenerator, state.DataFromIndex, err)
+			r.Log.Error(err, "unable to clean up generator state", "ExternalSecret", client.ObjectKeyFromObject(externalSecret), "index", state.DataFromIndex)
+			r.recorder.Event(externalSecret, v1.EventTypeWarning, esv1beta1.ReasonCleanupFailed, msg)
+			failed = append(failed, state)
+		}
+	}
+	return failed
+}
+
+// cleanupGeneratorState cleans up the values of a single state
+// using the generator definition stored alongside it.
+func (r *Reconciler) cleanupGeneratorState(ctx context.Context, namespace string, state esv1beta1.ExternalSecretGeneratorState) error {
+	if state.Resource == nil {
+		return fmt.Errorf(errMissingGeneratorResource)
+	}
+	gen, err := genv1alpha1.GetGenerator(state.Resource)
+	if err != nil {
+		return err
+	}
+	return gen.Cleanup(resolver.Context(ctx, &state.GeneratorRef), state.Resource, state.State, r.Client, namespace)
 }
 
 // getGeneratorDefinition returns the generator JSON for a given sourceRef
 // when it uses a generatorRef it fetches the resource and returns the JSON.
 func (r *Reconciler) getGeneratorDefinition(ctx context.Context, namespace string, generatorRef *esv1beta1.GeneratorRef) (*apiextensions.JSON, error) {
+	if generatorRef.Kind == genv1alpha1.ClusterGeneratorKind {
+		return resolver.ClusterGeneratorDefinition(ctx, r.Client, namespace, generatorRef.Name)
+	}
+
 	// client-go dynamic client needs a GVR to fetch the resource
 	// But we only have the GVK in our generatorRef.
 	//
@@ -214,11 +332,14 @@ func (r *Reconciler) handleFindAllSecrets(ctx context.Context, externalSecret *e
 	if err != nil {
 		return nil, err
 	}
-	secretMap, err := client.GetAllSecrets(ctx, *remoteRef.Find)
+	if _, ok := client.(esv1beta1.SecretsMetadataClient); !ok && utils.RewriteUsesMetadata(remoteRef.Rewrite) {
+		r.recorder.Event(externalSecret, v1.EventTypeWarning, esv1beta1.ReasonMetadataFailed, fmt.Sprintf(errRewriteMetadata, i))
+	}
+	secretMap, metadata, err := getAllSecrets(ctx, client, remoteRef)
 	if err != nil {
 		return nil, err
 	}
-	secretMap, err = utils.RewriteMap(remoteRef.Rewrite, secretMap)
+	secretMap, err = utils.RewriteMapWithMetadata(remoteRef.Rewrite, secretMap, metadata)
 	if err != nil {
 		return nil, fmt.Errorf(errRewrite, i, err)
 	}
@@ -249,3 +370,16 @@ func shouldSkipGenerator(r *Reconciler, generatorDef *apiextensions.JSON) (bool,
 	var controllerClass = genControllerClass.Spec.ControllerClass
 	return controllerClass != "" && controllerClass != r.ControllerClass, nil
 }
+
+// getAllSecrets finds the secrets of the remote ref. The metadata of the secrets
+// is only fetched for transform rewrites and if the provider returns it.
+func getAllSecrets(ctx context.Context, client esv1beta1.SecretsClient, remoteRef esv1beta1.ExternalSecretDataFromRemoteRef) (map[string][]byte, map[string]esv1beta1.SecretMetadata, error) {
+	metadataClient, ok := client.(esv1beta1.SecretsMetadataClient)
+	if ok && slices.ContainsFunc(remoteRef.Rewrite, func(op esv1beta1.ExternalSecretRewrite) bool {
+		return op.Transform != nil
+	}) {
+		return metadataClient.GetAllSecretsWithMetadata(ctx, *remoteRef.Find)
+	}
+	secretMap, err := client.GetAllSecrets(ctx, *remoteRef.Find)
+	return secretMap, nil, err
+}
@@ -19,11 +19,14 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	"github.com/external-secrets/external-secrets/pkg/controllers/templating"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/register" // Loading registered providers.
 	"github.com/external-secrets/external-secrets/pkg/template"
+	v2 "github.com/external-secrets/external-secrets/pkg/template/v2"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
@@ -31,7 +34,9 @@ import (
 // * template.Data (highest precedence)
 // * template.templateFrom
 // * secret via es.data or es.dataFrom.
-func (r *Reconciler) applyTemplate(ctx context.Context, es *esv1beta1.ExternalSecret, secret *v1.Secret, dataMap map[string][]byte) error {
+// existingSecret is the target secret as it was before the reconcile and exposed to the templates
+// together with the provider metadata of the keys in metadataMap.
+func (r *Reconciler) applyTemplate(ctx context.Context, es *esv1beta1.ExternalSecret, secret, existingSecret *v1.Secret, dataMap map[string][]byte, metadataMap map[string]esv1beta1.SecretMetadata) error {
 	if err := setMetadata(secret, es); err != nil {
 		return err
 	}
@@ -47,16 +52,22 @@ func (r *Reconciler) applyTemplate(ctx context.Context, es *esv1beta1.ExternalSe
 			secret.Data[k] = v
 		}
 	}
-	execute, err := template.EngineForVersion(es.Spec.Target.Template.EngineVersion)
+	p := templating.Parser{
+		Client:                        r.Client,
+		TargetSecret:                  secret,
+		DataMap:                       dataMap,
+		DisableClusterSecretTemplates: !r.ClusterSecretTemplateEnabled,
+	}
+	library, err := p.SecretTemplates(ctx, es.Namespace, es.Spec.Target.Template)
 	if err != nil {
-		return err
+		return fmt.Errorf(errFetchSecretTpl, err)
 	}
-
-	p := templating.Parser{
-		Client:       r.Client,
-		TargetSecret: secret,
-		DataMap:      dataMap,
-		Exec:         execute,
+	p.Exec, err = template.EngineWithOptions(es.Spec.Target.Template.EngineVersion, v2.Options{
+		Library: library,
+		Context: templateContext(es, existingSecret, metadataMap),
+	})
+	if err != nil {
+		return err
 	}
 	// apply templates defined in template.templateFrom
 	err = p.MergeTemplateFrom(ctx, es.Namespace, es.Spec.Target.Template)
@@ -68,6 +79,16 @@ func (r *Reconciler) applyTemplate(ctx context.Context, es *esv1beta1.ExternalSe
 	if err != nil {
 		return fmt.Errorf(errExecTpl, err)
 	}
+	// if no data was provided by template fallback
+	// to value from the provider, content types apply to it as well.
+	if len(es.Spec.Target.Template.Data) == 0 && len(es.Spec.Target.Template.TemplateFrom) == 0 {
+		secret.Data = make(map[string][]byte, len(dataMap))
+		p.SetData(dataMap)
+	}
+	err = p.ApplyContentTypes(es.Spec.Target.Template.ContentTypes)
+	if err != nil {
+		return fmt.Errorf(errExecTpl, err)
+	}
 
 	// get template data for labels
 	err = p.MergeMap(es.Spec.Target.Template.Metadata.Labels, esv1beta1.TemplateTargetLabels)
@@ -79,14 +100,25 @@ func (r *Reconciler) applyTemplate(ctx context.Context, es *esv1beta1.ExternalSe
 	if err != nil {
 		return fmt.Errorf(errExecTpl, err)
 	}
-	// if no data was provided by template fallback
-	// to value from the provider
-	if len(es.Spec.Target.Template.Data) == 0 && len(es.Spec.Target.Template.TemplateFrom) == 0 {
-		secret.Data = dataMap
-	}
 	return nil
 }
 
+// templateContext returns the context of the templates of the ExternalSecret.
+func templateContext(es *esv1beta1.ExternalSecret, existingSecret *v1.Secret, metadataMap map[string]esv1beta1.SecretMetadata) *v2.Context {
+	tplCtx := &v2.Context{
+		ExternalSecret: &es.ObjectMeta,
+		RemoteRefs:     make(map[string]esv1beta1.ExternalSecretDataRemoteRef, len(es.Spec.Data)),
+		Metadata:       metadataMap,
+	}
+	if existingSecret != nil && existingSecret.UID != "" {
+		tplCtx.TargetSecret = existingSecret
+	}
+	for _, data := range es.Spec.Data {
+		tplCtx.RemoteRefs[data.SecretKey] = data.RemoteRef
+	}
+	return tplCtx
+}
+
 // setMetadata sets Labels and Annotations to the given secret.
 func setMetadata(secret *v1.Secret, es *esv1beta1.ExternalSecret) error {
 	if secret.Labels == nil {
@@ -124,3 +156,27 @@ func setMetadata(secret *v1.Secret, es *esv1beta1.ExternalSecret) error {
 	utils.MergeStringMap(secret.ObjectMeta.Annotations, es.Spec.Target.Template.Metadata.Annotations)
 	return nil
 }
+
+// getTemplateVersion returns a hash of the versions of all objects the templates are read from,
+// so the secret is refreshed if one of them changes.
+// The versions are read from the manager cache, which holds the metadata of the watched
+// dependencies, so checking them does not cause API requests on every reconcile.
+func (r *Reconciler) getTemplateVersion(ctx context.Context, es *esv1beta1.ExternalSecret) string {
+	deps := templating.Dependencies(es.Namespace, es.Spec.Target.Template)
+	if len(deps) == 0 {
+		return ""
+	}
+	var reader client.Reader = r.Client
+	if r.cache != nil {
+		reader = r.cache
+	}
+	versions := make([]string, len(deps))
+	for i, dep := range deps {
+		// errors are not handled here, they are reported once the template is applied.
+		obj := dep.NewObject()
+		if err := reader.Get(ctx, types.NamespacedName{Namespace: dep.Namespace, Name: dep.Name}, obj); err == nil {
+			versions[i] = dep.String() + "=" + obj.GetResourceVersion()
+		}
+	}
+	return utils.ObjectHash(versions)
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -41,6 +43,7 @@ import (
 	ctest "github.com/external-secrets/external-secrets/pkg/controllers/commontest"
 	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret/esmetrics"
 	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
+	fakegen "github.com/external-secrets/external-secrets/pkg/generator/fake"
 	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
@@ -80,6 +83,47 @@ type testCase struct {
 
 type testTweaks func(*testCase)
 
+// statefulGenerator returns a new state on every generation
+// and keeps track of the states which have been cleaned up.
+// The first failCleanups cleanups fail.
+type statefulGenerator struct {
+	mu           sync.Mutex
+	generations  int
+	failCleanups int
+	cleaned      []string
+}
+
+func (g *statefulGenerator) Generate(_ context.Context, _ *apiextensions.JSON, _ client.Client, _ string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.generations++
+	return map[string][]byte{
+		"generation": []byte(strconv.Itoa(g.generations)),
+	}, &apiextensions.JSON{
+		Raw: []byte(fmt.Sprintf(`{"generation":%d}`, g.generations)),
+	}, nil
+}
+
+func (g *statefulGenerator) Cleanup(_ context.Context, obj *apiextensions.JSON, state genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if obj == nil || !strings.Contains(string(obj.Raw), `"kind":"Fake"`) {
+		return fmt.Errorf("unexpected generator definition")
+	}
+	if g.failCleanups > 0 {
+		g.failCleanups--
+		return fmt.Errorf("cleanup failed")
+	}
+	g.cleaned = append(g.cleaned, string(state.Raw))
+	return nil
+}
+
+func (g *statefulGenerator) cleanedUp() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string{}, g.cleaned...)
+}
+
 var _ = Describe("Kind=secret existence logic", func() {
 	validData := map[string][]byte{
 		"foo": []byte("value1"),
@@ -601,6 +645,212 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		}
 	}
 
+	clusterGeneratorRef := func(tc *testCase, name string) {
+		tc.externalSecret.Spec.SecretStoreRef = esv1beta1.SecretStoreRef{}
+		tc.externalSecret.Spec.Data = nil
+		tc.externalSecret.Spec.DataFrom = []esv1beta1.ExternalSecretDataFromRemoteRef{
+			{
+				SourceRef: &esv1beta1.StoreGeneratorSourceRef{
+					GeneratorRef: &esv1beta1.GeneratorRef{
+						APIVersion: genv1alpha1.Group + "/" + genv1alpha1.Version,
+						Kind:       genv1alpha1.ClusterGeneratorKind,
+						Name:       name,
+					},
+				},
+			},
+		}
+	}
+
+	syncWithClusterGeneratorRef := func(tc *testCase) {
+		const secretKey = "somekey"
+		const secretVal = "someValue"
+		name := "cluster-fake-" + ExternalSecretNamespace
+
+		Expect(k8sClient.Create(context.Background(), &genv1alpha1.ClusterGenerator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: genv1alpha1.ClusterGeneratorSpec{
+				Kind: genv1alpha1.GeneratorKindFake,
+				Generator: genv1alpha1.GeneratorSpec{
+					FakeSpec: &genv1alpha1.FakeSpec{
+						Data: map[string]string{
+							secretKey: secretVal,
+						},
+					},
+				},
+				Conditions: []esv1beta1.ClusterSecretStoreCondition{
+					{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{NamespaceLabelKey: NamespaceLabelValue},
+						},
+					},
+				},
+			},
+		})).To(Succeed())
+		clusterGeneratorRef(tc, name)
+
+		tc.checkSecret = func(es *esv1beta1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data[secretKey])).To(Equal(secretVal))
+		}
+	}
+
+	denyClusterGeneratorRef := func(tc *testCase) {
+		name := "cluster-fake-denied-" + ExternalSecretNamespace
+
+		Expect(k8sClient.Create(context.Background(), &genv1alpha1.ClusterGenerator{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: genv1alpha1.ClusterGeneratorSpec{
+				Kind: genv1alpha1.GeneratorKindFake,
+				Generator: genv1alpha1.GeneratorSpec{
+					FakeSpec: &genv1alpha1.FakeSpec{
+						Data: map[string]string{"foo": "bar"},
+					},
+				},
+				Conditions: []esv1beta1.ClusterSecretStoreCondition{
+					{
+						Namespaces: []string{"some-other-ns"},
+					},
+				},
+			},
+		})).To(Succeed())
+		clusterGeneratorRef(tc, name)
+
+		tc.checkCondition = func(es *esv1beta1.ExternalSecret) bool {
+			cond := GetExternalSecretCondition(es.Status, esv1beta1.ExternalSecretReady)
+			return cond != nil && cond.Status == v1.ConditionFalse && cond.Reason == esv1beta1.ConditionReasonSecretSyncedError
+		}
+	}
+
+	useStatefulGenerator := func(tc *testCase, gen *statefulGenerator) {
+		genv1alpha1.ForceRegister(genv1alpha1.FakeKind, gen)
+		DeferCleanup(func() {
+			genv1alpha1.ForceRegister(genv1alpha1.FakeKind, &fakegen.Generator{})
+		})
+
+		Expect(k8sClient.Create(context.Background(), &genv1alpha1.Fake{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mystatefulfake",
+				Namespace: ExternalSecretNamespace,
+			},
+		})).To(Succeed())
+
+		tc.externalSecret.Spec.SecretStoreRef = esv1beta1.SecretStoreRef{}
+		tc.externalSecret.Spec.Data = nil
+		tc.externalSecret.Spec.RefreshInterval = &metav1.Duration{Duration: time.Second}
+		tc.externalSecret.Spec.DataFrom = []esv1beta1.ExternalSecretDataFromRemoteRef{
+			{
+				SourceRef: &esv1beta1.StoreGeneratorSourceRef{
+					GeneratorRef: &esv1beta1.GeneratorRef{
+						APIVersion: genv1alpha1.Group + "/" + genv1alpha1.Version,
+						Kind:       "Fake",
+						Name:       "mystatefulfake",
+					},
+				},
+			},
+		}
+	}
+
+	cleanupPreviousGeneratorState := func(tc *testCase) {
+		gen := &statefulGenerator{}
+		useStatefulGenerator(tc, gen)
+
+		tc.checkExternalSecret = func(es *esv1beta1.ExternalSecret) {
+			// the state of the first generation is cleaned up once the secret has been refreshed
+			Eventually(func() []string {
+				return gen.cleanedUp()
+			}, timeout, interval).Should(ContainElement(`{"generation":1}`))
+
+			Eventually(func() bool {
+				var updated esv1beta1.ExternalSecret
+				if err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(es), &updated); err != nil {
+					return false
+				}
+				states := updated.Status.GeneratorStates
+				return len(states) == 1 &&
+					states[0].DataFromIndex == 0 &&
+					states[0].GeneratorRef.Name == "mystatefulfake" &&
+					string(states[0].State.Raw) != `{"generation":1}`
+			}, timeout, interval).Should(BeTrue())
+		}
+	}
+
+	retryGeneratorStateCleanup := func(tc *testCase) {
+		gen := &statefulGenerator{failCleanups: 1}
+		useStatefulGenerator(tc, gen)
+
+		tc.checkExternalSecret = func(es *esv1beta1.ExternalSecret) {
+			// the state of the first generation is kept until its cleanup succeeds
+			Eventually(func() []string {
+				return gen.cleanedUp()
+			}, timeout, interval).Should(ContainElement(`{"generation":1}`))
+
+			Eventually(func() bool {
+				var updated esv1beta1.ExternalSecret
+				if err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(es), &updated); err != nil {
+					return false
+				}
+				for _, state := range updated.Status.StaleGeneratorStates {
+					if string(state.State.Raw) == `{"generation":1}` {
+						return false
+					}
+				}
+				return true
+			}, timeout, interval).Should(BeTrue())
+		}
+	}
+
+	cleanupGeneratorStateOnDelete := func(tc *testCase) {
+		gen := &statefulGenerator{}
+		useStatefulGenerator(tc, gen)
+		tc.externalSecret.Spec.RefreshInterval = &metav1.Duration{Duration: time.Hour}
+
+		tc.checkExternalSecret = func(es *esv1beta1.ExternalSecret) {
+			var updated esv1beta1.ExternalSecret
+			Eventually(func() int {
+				if err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(es), &updated); err != nil {
+					return 0
+				}
+				return len(updated.Status.GeneratorStates)
+			}, timeout, interval).Should(Equal(1))
+			Expect(updated.Finalizers).To(ContainElement("externalsecret.externalsecrets.io/finalizer"))
+
+			Expect(k8sClient.Delete(context.Background(), &updated)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(es), &updated)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+			Expect(gen.cleanedUp()).To(ContainElement(`{"generation":1}`))
+		}
+	}
+
+	keepGeneratorStateOnDeleteWithOrphanPolicy := func(tc *testCase) {
+		gen := &statefulGenerator{}
+		useStatefulGenerator(tc, gen)
+		tc.externalSecret.Spec.RefreshInterval = &metav1.Duration{Duration: time.Hour}
+		tc.externalSecret.Spec.Target.CreationPolicy = esv1beta1.CreatePolicyOrphan
+
+		tc.checkExternalSecret = func(es *esv1beta1.ExternalSecret) {
+			var updated esv1beta1.ExternalSecret
+			Eventually(func() int {
+				if err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(es), &updated); err != nil {
+					return 0
+				}
+				return len(updated.Status.GeneratorStates)
+			}, timeout, interval).Should(Equal(1))
+
+			// the orphaned secret keeps using the generated values
+			Expect(k8sClient.Delete(context.Background(), &updated)).To(Succeed())
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(es), &updated)
+				return apierrors.IsNotFound(err)
+			}, timeout, interval).Should(BeTrue())
+			Expect(gen.cleanedUp()).NotTo(ContainElement(`{"generation":1}`))
+		}
+	}
+
 	deleteOrphanedSecrets := func(tc *testCase) {
 		tc.checkSecret = func(es *esv1beta1.ExternalSecret, secret *v1.Secret) {
 			cleanEs := es.DeepCopy()
@@ -801,6 +1051,38 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 			Expect(string(secret.Data[targetProp])).To(Equal(expectedSecretVal))
 		}
 	}
+
+	// when referencing a SecretTemplate its templates should be available to include
+	syncWithSecretTemplate := func(tc *testCase) {
+		const secretVal = "someValue"
+		secretTemplate := &esv1beta1.SecretTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "library",
+				Namespace: ExternalSecretNamespace,
+			},
+			Spec: esv1beta1.SecretTemplateSpec{
+				Templates: map[string]string{
+					"shout": "{{ . | upper }} was templated",
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secretTemplate)).To(Succeed())
+		tc.externalSecret.Spec.Target.Template = &esv1beta1.ExternalSecretTemplate{
+			Type: v1.SecretTypeOpaque,
+			SecretTemplateRefs: []esv1beta1.SecretTemplateRef{
+				{Name: secretTemplate.Name},
+			},
+			Data: map[string]string{
+				targetProp: `{{ include "shout" .targetProperty }}`,
+			},
+		}
+		fakeProvider.WithGetSecret([]byte(secretVal), nil)
+		tc.checkSecret = func(es *esv1beta1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data[targetProp])).To(Equal(expectedSecretVal))
+			Expect(es.Status.SyncedTemplateVersion).ToNot(BeEmpty())
+		}
+	}
+
 	// // secret should be synced with correct value precedence:
 	// // * fromString
 	// // * template data
@@ -961,6 +1243,62 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		}
 	}
 
+	// a change to a template ConfigMap should be picked up
+	// even if the ExternalSecret is never refreshed
+	refreshTemplateFromConfigMap := func(tc *testCase) {
+		const secretVal = "someValue"
+		const tplFromCMName = "template-cm"
+		const tplFromKey = "tpl-from-key"
+		cm := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tplFromCMName,
+				Namespace: ExternalSecretNamespace,
+			},
+			Data: map[string]string{
+				tplFromKey: "{{ .targetProperty }}",
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), cm)).To(Succeed())
+		tc.externalSecret.Spec.RefreshInterval = &metav1.Duration{Duration: 0}
+		tc.externalSecret.Spec.Target.Template = &esv1beta1.ExternalSecretTemplate{
+			Type: v1.SecretTypeOpaque,
+			TemplateFrom: []esv1beta1.TemplateFrom{
+				{
+					ConfigMap: &esv1beta1.TemplateRef{
+						Name: tplFromCMName,
+						Items: []esv1beta1.TemplateRefItem{
+							{
+								Key: tplFromKey,
+							},
+						},
+					},
+				},
+			},
+		}
+		fakeProvider.WithGetSecret([]byte(secretVal), nil)
+		tc.checkSecret = func(es *esv1beta1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data[tplFromKey])).To(Equal(secretVal))
+
+			// update the template
+			cleanCM := cm.DeepCopy()
+			cm.Data[tplFromKey] = "{{ .targetProperty | upper }}"
+			Expect(k8sClient.Patch(context.Background(), cm, client.MergeFrom(cleanCM))).To(Succeed())
+
+			sec := &v1.Secret{}
+			secretLookupKey := types.NamespacedName{
+				Name:      ExternalSecretTargetSecretName,
+				Namespace: ExternalSecretNamespace,
+			}
+			Eventually(func() bool {
+				err := k8sClient.Get(context.Background(), secretLookupKey, sec)
+				if err != nil {
+					return false
+				}
+				return string(sec.Data[tplFromKey]) == "SOMEVALUE"
+			}, time.Second*10, time.Millisecond*200).Should(BeTrue())
+		}
+	}
+
 	syncTemplateFromLiteral := func(tc *testCase) {
 		tplDataVal := "{{ .targetKey }}-literal: {{ .targetValue }}"
 		tplAnnotationsVal := "{{ .targetKey }}-annotations: {{ .targetValue }}"
@@ -2230,12 +2568,20 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		Entry("should not update unchanged secret using creationPolicy=Merge", mergeWithSecretNoChange),
 		Entry("should not delete pre-existing secret with creationPolicy=Orphan", createSecretPolicyOrphan),
 		Entry("should sync with generatorRef", syncWithGeneratorRef),
+		Entry("should clean up the generator state of the previous sync", cleanupPreviousGeneratorState),
+		Entry("should retry the cleanup of generator states", retryGeneratorStateCleanup),
+		Entry("should clean up the generator state when the ExternalSecret is deleted", cleanupGeneratorStateOnDelete),
+		Entry("should keep the generator state in use when an ExternalSecret with the Orphan policy is deleted", keepGeneratorStateOnDeleteWithOrphanPolicy),
+		Entry("should sync with a ClusterGenerator", syncWithClusterGeneratorRef),
+		Entry("should not sync with a ClusterGenerator which does not allow the namespace", denyClusterGeneratorRef),
 		Entry("should not process generatorRef with mismatching controller field", ignoreMismatchControllerForGeneratorRef),
 		Entry("should sync with multiple secret stores via sourceRef", syncWithMultipleSecretStores),
 		Entry("should sync with template", syncWithTemplate),
 		Entry("should sync with template engine v2", syncWithTemplateV2),
+		Entry("should sync with secret template", syncWithSecretTemplate),
 		Entry("should sync template with correct value precedence", syncWithTemplatePrecedence),
 		Entry("should sync template from keys and values", syncTemplateFromKeysAndValues),
+		Entry("should refresh secret when template ConfigMap changes", refreshTemplateFromConfigMap),
 		Entry("should sync template from literal", syncTemplateFromLiteral),
 		Entry("should update template if ExternalSecret is updated", templateShouldRewrite),
 		Entry("should keep data with templates if MergePolicy=Merge", templateShouldMerge),
@@ -2400,6 +2746,34 @@ var _ = Describe("ExternalSecret refresh logic", func() {
 			Expect(shouldRefresh(es)).To(BeTrue())
 		})
 
+		It("should refresh before the synced values expire", func() {
+			refreshTime := metav1.NewTime(time.Now().Add(-time.Minute * 9))
+			es := esv1beta1.ExternalSecret{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Spec: esv1beta1.ExternalSecretSpec{
+					RefreshInterval: &metav1.Duration{Duration: time.Hour},
+				},
+				Status: esv1beta1.ExternalSecretStatus{
+					RefreshTime:    refreshTime,
+					ExpirationTime: &metav1.Time{Time: refreshTime.Add(time.Minute * 20)},
+				},
+			}
+			// resource version matches
+			es.Status.SyncedResourceVersion = getResourceVersion(es)
+			Expect(shouldRefresh(es)).To(BeFalse())
+			// the refresh is scheduled after 80% of the lifetime instead of the refresh interval
+			Expect(refreshBeforeExpiration(time.Hour, es)).To(BeNumerically("~", time.Minute*7, time.Second*5))
+
+			// 80% of the lifetime have passed
+			es.Status.ExpirationTime = &metav1.Time{Time: refreshTime.Add(time.Minute * 10)}
+			Expect(shouldRefresh(es)).To(BeTrue())
+			Expect(refreshBeforeExpiration(time.Hour, es)).To(Equal(time.Second))
+			// a refresh interval of 0 is kept
+			Expect(refreshBeforeExpiration(0, es)).To(Equal(time.Duration(0)))
+		})
+
 	})
 	Context("objectmeta hash", func() {
 		It("should produce different hashes for different k/v pairs", func() {
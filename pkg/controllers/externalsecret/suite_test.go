@@ -89,12 +89,13 @@ var _ = BeforeSuite(func() {
 	Expect(err).ToNot(HaveOccurred())
 
 	err = (&Reconciler{
-		Client:                    k8sClient,
-		RestConfig:                cfg,
-		Scheme:                    k8sManager.GetScheme(),
-		Log:                       ctrl.Log.WithName("controllers").WithName("ExternalSecrets"),
-		RequeueInterval:           time.Second,
-		ClusterSecretStoreEnabled: true,
+		Client:                       k8sClient,
+		RestConfig:                   cfg,
+		Scheme:                       k8sManager.GetScheme(),
+		Log:                          ctrl.Log.WithName("controllers").WithName("ExternalSecrets"),
+		RequeueInterval:              time.Second,
+		ClusterSecretStoreEnabled:    true,
+		ClusterSecretTemplateEnabled: true,
 	}).SetupWithManager(k8sManager, controller.Options{
 		MaxConcurrentReconciles: 1,
 	})
@@ -25,12 +25,14 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/controllers/templating"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/register" // Loading registered providers.
 	"github.com/external-secrets/external-secrets/pkg/template"
+	v2 "github.com/external-secrets/external-secrets/pkg/template/v2"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
 const (
-	errFetchTplFrom = "error fetching templateFrom data: %w"
-	errExecTpl      = "could not execute template: %w"
+	errFetchTplFrom   = "error fetching templateFrom data: %w"
+	errFetchSecretTpl = "error fetching secret templates: %w"
+	errExecTpl        = "could not execute template: %w"
 )
 
 // applyTemplate merges template in the following order:
@@ -49,16 +51,18 @@ func (r *Reconciler) applyTemplate(ctx context.Context, ps *v1alpha1.PushSecret,
 		return err
 	}
 
-	execute, err := template.EngineForVersion(esv1beta1.TemplateEngineV2)
-	if err != nil {
-		return err
-	}
-
 	p := templating.Parser{
 		Client:       r.Client,
 		TargetSecret: secret,
 		DataMap:      secret.Data,
-		Exec:         execute,
+	}
+	library, err := p.SecretTemplates(ctx, ps.Namespace, ps.Spec.Template)
+	if err != nil {
+		return fmt.Errorf(errFetchSecretTpl, err)
+	}
+	p.Exec, err = template.EngineWithOptions(esv1beta1.TemplateEngineV2, v2.Options{Library: library})
+	if err != nil {
+		return err
 	}
 
 	// apply templates defined in template.templateFrom
@@ -71,6 +75,15 @@ func (r *Reconciler) applyTemplate(ctx context.Context, ps *v1alpha1.PushSecret,
 	if err != nil {
 		return fmt.Errorf(errExecTpl, err)
 	}
+	// if no data was provided by template, the source data is passed through
+	// and content types apply to it as well.
+	if len(ps.Spec.Template.Data) == 0 && len(ps.Spec.Template.TemplateFrom) == 0 {
+		p.SetData(secret.Data)
+	}
+	err = p.ApplyContentTypes(ps.Spec.Template.ContentTypes)
+	if err != nil {
+		return fmt.Errorf(errExecTpl, err)
+	}
 
 	// get template data for labels
 	err = p.MergeMap(ps.Spec.Template.Metadata.Labels, esv1beta1.TemplateTargetLabels)
@@ -638,6 +638,44 @@ var _ = Describe("ExternalSecret controller", func() {
 		}
 	}
 	// if conversion strategy is defined, revert the keys based on the strategy.
+	syncSuccessfullyWithSecretTemplate := func(tc *testCase) {
+		fakeProvider.SetSecretFn = func() error {
+			return nil
+		}
+		secretTemplate := &v1beta1.SecretTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "library",
+				Namespace: PushSecretNamespace,
+			},
+			Spec: v1beta1.SecretTemplateSpec{
+				Templates: map[string]string{
+					"shout": "{{ . | toString | upper }} was templated",
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), secretTemplate)).To(Succeed())
+		tc.pushsecret.Spec.Template = &v1beta1.ExternalSecretTemplate{
+			EngineVersion: v1beta1.TemplateEngineV2,
+			SecretTemplateRefs: []v1beta1.SecretTemplateRef{
+				{Name: secretTemplate.Name},
+			},
+			Data: map[string]string{
+				defaultKey: `{{ include "shout" .key }}`,
+			},
+		}
+		tc.assert = func(ps *v1alpha1.PushSecret, secret *v1.Secret) bool {
+			Eventually(func() bool {
+				By("checking if Provider value got updated")
+				providerValue, ok := fakeProvider.SetSecretArgs[ps.Spec.Data[0].Match.RemoteRef.RemoteKey]
+				if !ok {
+					return false
+				}
+				return bytes.Equal(providerValue.Value, []byte("VALUE was templated"))
+			}, time.Second*10, time.Second).Should(BeTrue())
+			return true
+		}
+	}
+
 	syncSuccessfullyWithConversionStrategy := func(tc *testCase) {
 		fakeProvider.SetSecretFn = func() error {
 			return nil
@@ -995,6 +1033,7 @@ var _ = Describe("ExternalSecret controller", func() {
 		Entry("should update the PushSecret status correctly if UpdatePolicy=IfNotExists", updateIfNotExistsSyncStatus),
 		Entry("should fail if secret existence cannot be verified if UpdatePolicy=IfNotExists", updateIfNotExistsSyncFailed),
 		Entry("should sync with template", syncSuccessfullyWithTemplate),
+		Entry("should sync with secret template", syncSuccessfullyWithSecretTemplate),
 		Entry("should sync with conversion strategy", syncSuccessfullyWithConversionStrategy),
 		Entry("should delete if DeletionPolicy=Delete", syncAndDeleteSuccessfully),
 		Entry("should track deletion tasks if Delete fails", failDelete),
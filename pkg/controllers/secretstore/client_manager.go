@@ -18,16 +18,16 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
-	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
 
 const (
@@ -50,6 +50,8 @@ type Manager struct {
 
 	// store clients by provider type
 	clientMap map[clientKey]*clientVal
+	// expiration of the values read by clients which have been closed already
+	expiration time.Time
 }
 
 type clientKey struct {
@@ -116,7 +118,7 @@ func (m *Manager) Get(ctx context.Context, storeRef esv1beta1.SecretStoreRef, na
 		return nil, fmt.Errorf("can not reference unmanaged store")
 	}
 	// when using ClusterSecretStore, validate the ClusterSecretStore namespace conditions
-	shouldProcess, err := m.shouldProcessSecret(store, namespace)
+	shouldProcess, err := m.shouldProcessSecret(ctx, store, namespace)
 	if err != nil || !shouldProcess {
 		if err == nil && !shouldProcess {
 			err = fmt.Errorf(errClusterStoreMismatch, store.GetName(), namespace)
@@ -157,6 +159,7 @@ func (m *Manager) getStoredClient(ctx context.Context, storeProvider esv1beta1.P
 		"store", storeName)
 	// if we have a client but it points to a different store
 	// we must clean it up
+	m.expiration = earliestExpiration(m.expiration, val.client)
 	val.client.Close(ctx)
 	delete(m.clientMap, idx)
 	return nil
@@ -195,6 +198,7 @@ func (m *Manager) getStore(ctx context.Context, storeRef *esv1beta1.SecretStoreR
 func (m *Manager) Close(ctx context.Context) error {
 	var errs []string
 	for key, val := range m.clientMap {
+		m.expiration = earliestExpiration(m.expiration, val.client)
 		err := val.client.Close(ctx)
 		if err != nil {
 			errs = append(errs, err.Error())
@@ -207,43 +211,33 @@ func (m *Manager) Close(ctx context.Context) error {
 	return nil
 }
 
-func (m *Manager) shouldProcessSecret(store esv1beta1.GenericStore, ns string) (bool, error) {
-	if store.GetKind() != esv1beta1.ClusterSecretStoreKind {
-		return true, nil
+// Expiration returns the earliest time a value read by one of the clients expires.
+// It returns the zero time if none of the values expire.
+func (m *Manager) Expiration() time.Time {
+	expiration := m.expiration
+	for _, val := range m.clientMap {
+		expiration = earliestExpiration(expiration, val.client)
 	}
+	return expiration
+}
 
-	if len(store.GetSpec().Conditions) == 0 {
-		return true, nil
+func earliestExpiration(expiration time.Time, secretClient esv1beta1.SecretsClient) time.Time {
+	expClient, ok := secretClient.(esv1beta1.SecretsExpirationClient)
+	if !ok {
+		return expiration
 	}
-
-	namespaceList := &v1.NamespaceList{}
-
-	for _, condition := range store.GetSpec().Conditions {
-		if condition.NamespaceSelector != nil {
-			namespaceSelector, err := metav1.LabelSelectorAsSelector(condition.NamespaceSelector)
-			if err != nil {
-				return false, err
-			}
-
-			if err := m.client.List(context.Background(), namespaceList, client.MatchingLabelsSelector{Selector: namespaceSelector}); err != nil {
-				return false, err
-			}
-
-			for _, namespace := range namespaceList.Items {
-				if namespace.GetName() == ns {
-					return true, nil // namespace matches the labelselector
-				}
-			}
-		}
-
-		if condition.Namespaces != nil {
-			if slices.Contains(condition.Namespaces, ns) {
-				return true, nil // namespace in the namespaces list
-			}
-		}
+	clientExpiration := expClient.Expiration()
+	if expiration.IsZero() || (!clientExpiration.IsZero() && clientExpiration.Before(expiration)) {
+		return clientExpiration
 	}
+	return expiration
+}
 
-	return false, nil
+func (m *Manager) shouldProcessSecret(ctx context.Context, store esv1beta1.GenericStore, ns string) (bool, error) {
+	if store.GetKind() != esv1beta1.ClusterSecretStoreKind {
+		return true, nil
+	}
+	return resolvers.NamespaceMatchesConditions(ctx, m.client, store.GetSpec().Conditions, ns)
 }
 
 // assertStoreIsUsable assert that the store is ready to use.
@@ -74,7 +74,7 @@ func reconcile(ctx context.Context, req ctrl.Request, ss esapi.GenericStore, cl
 		return ctrl.Result{}, err
 	}
 	capStatus := esapi.SecretStoreStatus{
-		Capabilities: storeProvider.Capabilities(),
+		Capabilities: storeCapabilities(storeProvider, ss),
 		Conditions:   ss.GetStatus().Conditions,
 	}
 	ss.SetStatus(capStatus)
@@ -88,6 +88,15 @@ func reconcile(ctx context.Context, req ctrl.Request, ss esapi.GenericStore, cl
 	}, err
 }
 
+// storeCapabilities returns the capabilities of the store,
+// which may depend on its configuration.
+func storeCapabilities(storeProvider esapi.Provider, store esapi.GenericStore) esapi.SecretStoreCapabilities {
+	if p, ok := storeProvider.(esapi.StoreCapabilitiesProvider); ok {
+		return p.StoreCapabilities(store)
+	}
+	return storeProvider.Capabilities()
+}
+
 // validateStore tries to construct a new client
 // if it fails sets a condition and writes events.
 func validateStore(ctx context.Context, namespace, controllerClass string, store esapi.GenericStore,
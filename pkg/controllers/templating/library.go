@@ -0,0 +1,154 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templating
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+var (
+	errTplKind       = "unknown secret template kind %q"
+	errClusterTplNS  = "ClusterSecretTemplate %q can not be used from namespace %q"
+	errClusterTplOff = "ClusterSecretTemplate %q can not be used: ClusterSecretTemplates are disabled"
+)
+
+const (
+	// KindConfigMap is the kind of a ConfigMap dependency.
+	KindConfigMap = "ConfigMap"
+	// KindSecret is the kind of a Secret dependency.
+	KindSecret = "Secret"
+)
+
+// Dependency identifies an object the templates of a resource are read from.
+type Dependency struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String returns a unique key of the dependency.
+func (d Dependency) String() string {
+	if d.Namespace == "" {
+		return d.Kind + "/" + d.Name
+	}
+	return d.Kind + "/" + d.Namespace + "/" + d.Name
+}
+
+// NewObject returns an empty object of the kind of the dependency.
+// ConfigMaps and Secrets are returned as metadata only,
+// so they are not cached in full.
+func (d Dependency) NewObject() client.Object {
+	switch d.Kind {
+	case KindConfigMap, KindSecret:
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind(d.Kind))
+		return obj
+	case esv1beta1.ClusterSecretTemplateKind:
+		return &esv1beta1.ClusterSecretTemplate{}
+	default:
+		return &esv1beta1.SecretTemplate{}
+	}
+}
+
+// Dependencies returns the objects the given template reads templates from.
+func Dependencies(namespace string, template *esv1beta1.ExternalSecretTemplate) []Dependency {
+	if template == nil {
+		return nil
+	}
+	var deps []Dependency
+	for _, tpl := range template.TemplateFrom {
+		if tpl.ConfigMap != nil {
+			deps = append(deps, Dependency{Kind: KindConfigMap, Namespace: namespace, Name: tpl.ConfigMap.Name})
+		}
+		if tpl.Secret != nil {
+			deps = append(deps, Dependency{Kind: KindSecret, Namespace: namespace, Name: tpl.Secret.Name})
+		}
+	}
+	for _, ref := range template.SecretTemplateRefs {
+		switch secretTemplateKind(ref) {
+		case esv1beta1.SecretTemplateKind:
+			deps = append(deps, Dependency{Kind: esv1beta1.SecretTemplateKind, Namespace: namespace, Name: ref.Name})
+		case esv1beta1.ClusterSecretTemplateKind:
+			deps = append(deps, Dependency{Kind: esv1beta1.ClusterSecretTemplateKind, Name: ref.Name})
+		}
+	}
+	return deps
+}
+
+// SecretTemplates returns the named templates of all SecretTemplates and ClusterSecretTemplates
+// referenced by the template. Templates of later references take precedence.
+func (p *Parser) SecretTemplates(ctx context.Context, namespace string, template *esv1beta1.ExternalSecretTemplate) (map[string]string, error) {
+	if template == nil || len(template.SecretTemplateRefs) == 0 {
+		return nil, nil
+	}
+	library := make(map[string]string)
+	for _, ref := range template.SecretTemplateRefs {
+		templates, err := p.secretTemplate(ctx, namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		for name, text := range templates {
+			library[name] = text
+		}
+	}
+	return library, nil
+}
+
+func (p *Parser) secretTemplate(ctx context.Context, namespace string, ref esv1beta1.SecretTemplateRef) (map[string]string, error) {
+	switch secretTemplateKind(ref) {
+	case esv1beta1.SecretTemplateKind:
+		var st esv1beta1.SecretTemplate
+		err := p.Client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &st)
+		if err != nil {
+			return nil, err
+		}
+		return st.Spec.Templates, nil
+	case esv1beta1.ClusterSecretTemplateKind:
+		if p.DisableClusterSecretTemplates {
+			return nil, fmt.Errorf(errClusterTplOff, ref.Name)
+		}
+		var cst esv1beta1.ClusterSecretTemplate
+		err := p.Client.Get(ctx, types.NamespacedName{Name: ref.Name}, &cst)
+		if err != nil {
+			return nil, err
+		}
+		allowed, err := resolvers.NamespaceMatchesConditions(ctx, p.Client, cst.Spec.Conditions, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf(errClusterTplNS, ref.Name, namespace)
+		}
+		return cst.Spec.Templates, nil
+	default:
+		return nil, fmt.Errorf(errTplKind, ref.Kind)
+	}
+}
+
+func secretTemplateKind(ref esv1beta1.SecretTemplateRef) string {
+	if ref.Kind == "" {
+		return esv1beta1.SecretTemplateKind
+	}
+	return ref.Kind
+}
@@ -0,0 +1,176 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templating
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	pointer "k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestSecretTemplates(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, esv1beta1.AddToScheme(scheme))
+
+	objects := []client.Object{
+		&v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}},
+		},
+		&esv1beta1.SecretTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "team-a"},
+			Spec: esv1beta1.SecretTemplateSpec{
+				Templates: map[string]string{"dsn": "local", "user": "local"},
+			},
+		},
+		&esv1beta1.ClusterSecretTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "global"},
+			Spec: esv1beta1.ClusterSecretTemplateSpec{
+				Templates: map[string]string{"dsn": "global"},
+			},
+		},
+		&esv1beta1.ClusterSecretTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "by-name"},
+			Spec: esv1beta1.ClusterSecretTemplateSpec{
+				Templates:  map[string]string{"dsn": "by-name"},
+				Conditions: []esv1beta1.ClusterSecretStoreCondition{{Namespaces: []string{"team-a"}}},
+			},
+		},
+		&esv1beta1.ClusterSecretTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "by-label"},
+			Spec: esv1beta1.ClusterSecretTemplateSpec{
+				Templates: map[string]string{"dsn": "by-label"},
+				Conditions: []esv1beta1.ClusterSecretStoreCondition{{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				}},
+			},
+		},
+		&esv1beta1.ClusterSecretTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "forbidden"},
+			Spec: esv1beta1.ClusterSecretTemplateSpec{
+				Templates:  map[string]string{"dsn": "forbidden"},
+				Conditions: []esv1beta1.ClusterSecretStoreCondition{{Namespaces: []string{"team-b"}}},
+			},
+		},
+	}
+	p := &Parser{
+		Client: clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+	}
+
+	tbl := []struct {
+		name   string
+		refs   []esv1beta1.SecretTemplateRef
+		want   map[string]string
+		expErr string
+	}{
+		{
+			name: "no refs",
+		},
+		{
+			name: "namespaced template by default",
+			refs: []esv1beta1.SecretTemplateRef{{Name: "local"}},
+			want: map[string]string{"dsn": "local", "user": "local"},
+		},
+		{
+			name: "later refs take precedence",
+			refs: []esv1beta1.SecretTemplateRef{
+				{Name: "local"},
+				{Kind: esv1beta1.ClusterSecretTemplateKind, Name: "global"},
+			},
+			want: map[string]string{"dsn": "global", "user": "local"},
+		},
+		{
+			name: "cluster template allowed by namespace",
+			refs: []esv1beta1.SecretTemplateRef{{Kind: esv1beta1.ClusterSecretTemplateKind, Name: "by-name"}},
+			want: map[string]string{"dsn": "by-name"},
+		},
+		{
+			name: "cluster template allowed by label",
+			refs: []esv1beta1.SecretTemplateRef{{Kind: esv1beta1.ClusterSecretTemplateKind, Name: "by-label"}},
+			want: map[string]string{"dsn": "by-label"},
+		},
+		{
+			name:   "cluster template not allowed",
+			refs:   []esv1beta1.SecretTemplateRef{{Kind: esv1beta1.ClusterSecretTemplateKind, Name: "forbidden"}},
+			expErr: `ClusterSecretTemplate "forbidden" can not be used from namespace "team-a"`,
+		},
+		{
+			name:   "missing template",
+			refs:   []esv1beta1.SecretTemplateRef{{Name: "missing"}},
+			expErr: "not found",
+		},
+		{
+			name:   "unknown kind",
+			refs:   []esv1beta1.SecretTemplateRef{{Kind: "Foo", Name: "local"}},
+			expErr: `unknown secret template kind "Foo"`,
+		},
+	}
+	for i := range tbl {
+		row := tbl[i]
+		t.Run(row.name, func(t *testing.T) {
+			got, err := p.SecretTemplates(context.Background(), "team-a", &esv1beta1.ExternalSecretTemplate{
+				SecretTemplateRefs: row.refs,
+			})
+			if row.expErr != "" {
+				assert.ErrorContains(t, err, row.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, row.want, got)
+		})
+	}
+}
+
+func TestSecretTemplatesDisabled(t *testing.T) {
+	p := &Parser{
+		Client:                        clientfake.NewClientBuilder().Build(),
+		DisableClusterSecretTemplates: true,
+	}
+	_, err := p.SecretTemplates(context.Background(), "team-a", &esv1beta1.ExternalSecretTemplate{
+		SecretTemplateRefs: []esv1beta1.SecretTemplateRef{{Kind: esv1beta1.ClusterSecretTemplateKind, Name: "global"}},
+	})
+	assert.ErrorContains(t, err, `ClusterSecretTemplate "global" can not be used: ClusterSecretTemplates are disabled`)
+}
+
+func TestDependencies(t *testing.T) {
+	deps := Dependencies("team-a", &esv1beta1.ExternalSecretTemplate{
+		TemplateFrom: []esv1beta1.TemplateFrom{
+			{ConfigMap: &esv1beta1.TemplateRef{Name: "tpl"}},
+			{Secret: &esv1beta1.TemplateRef{Name: "tpl"}},
+			{Literal: pointer.To("foo: bar")},
+		},
+		SecretTemplateRefs: []esv1beta1.SecretTemplateRef{
+			{Name: "local"},
+			{Kind: esv1beta1.ClusterSecretTemplateKind, Name: "global"},
+		},
+	})
+	keys := make([]string, 0, len(deps))
+	for _, dep := range deps {
+		keys = append(keys, dep.String())
+	}
+	assert.Equal(t, []string{"ConfigMap/team-a/tpl", "Secret/team-a/tpl", "SecretTemplate/team-a/local", "ClusterSecretTemplate/global"}, keys)
+	assert.Nil(t, Dependencies("team-a", nil))
+}
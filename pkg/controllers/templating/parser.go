@@ -16,9 +16,11 @@ package templating
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,6 +36,9 @@ var (
 	errTplCMMissingKey  = "error in configmap %s: missing key %s"
 	errTplSecMissingKey = "error in secret %s: missing key %s"
 	errExecTpl          = "could not execute template: %w"
+	errContentText      = "rendered value of key %s is not valid UTF-8"
+	errContentBase64    = "could not decode rendered value of key %s: %w"
+	errContentType      = "unknown content type %q of key %s"
 )
 
 type Parser struct {
@@ -41,6 +46,41 @@ type Parser struct {
 	DataMap      map[string][]byte
 	Client       client.Client
 	TargetSecret *v1.Secret
+	// DisableClusterSecretTemplates rejects references to ClusterSecretTemplates.
+	DisableClusterSecretTemplates bool
+
+	// renderedKeys are the data keys written by templates, content types only apply to them.
+	renderedKeys map[string]struct{}
+}
+
+// exec renders the templates into the target secret
+// and records the data keys they write.
+func (p *Parser) exec(tpl map[string][]byte, scope esv1beta1.TemplateScope, target esv1beta1.TemplateTarget) error {
+	if target != esv1beta1.TemplateTargetData {
+		return p.Exec(tpl, p.DataMap, scope, target, p.TargetSecret)
+	}
+	rendered := &v1.Secret{Data: make(map[string][]byte)}
+	if err := p.Exec(tpl, p.DataMap, scope, target, rendered); err != nil {
+		return err
+	}
+	p.SetData(rendered.Data)
+	return nil
+}
+
+// SetData writes the data to the target secret as if it had been rendered,
+// so content types apply to it. It is used to pass the data through
+// when the template does not render any data keys.
+func (p *Parser) SetData(data map[string][]byte) {
+	if p.TargetSecret.Data == nil {
+		p.TargetSecret.Data = make(map[string][]byte, len(data))
+	}
+	if p.renderedKeys == nil {
+		p.renderedKeys = make(map[string]struct{}, len(data))
+	}
+	for k, v := range data {
+		p.TargetSecret.Data[k] = v
+		p.renderedKeys[k] = struct{}{}
+	}
 }
 
 func (p *Parser) MergeConfigMap(ctx context.Context, namespace string, tpl esv1beta1.TemplateFrom) error {
@@ -62,12 +102,13 @@ func (p *Parser) MergeConfigMap(ctx context.Context, namespace string, tpl esv1b
 			return fmt.Errorf(errTplCMMissingKey, tpl.ConfigMap.Name, k.Key)
 		}
 		switch k.TemplateAs {
-		case esv1beta1.TemplateScopeValues:
+		case esv1beta1.TemplateScopeValues, esv1beta1.TemplateScopeEnv, esv1beta1.TemplateScopeProperties,
+			esv1beta1.TemplateScopeINI, esv1beta1.TemplateScopeTOML:
 			out[k.Key] = []byte(val)
 		case esv1beta1.TemplateScopeKeysAndValues:
 			out[val] = []byte(val)
 		}
-		err = p.Exec(out, p.DataMap, k.TemplateAs, tpl.Target, p.TargetSecret)
+		err = p.exec(out, k.TemplateAs, tpl.Target)
 		if err != nil {
 			return err
 		}
@@ -94,12 +135,13 @@ func (p *Parser) MergeSecret(ctx context.Context, namespace string, tpl esv1beta
 		}
 		out := make(map[string][]byte)
 		switch k.TemplateAs {
-		case esv1beta1.TemplateScopeValues:
+		case esv1beta1.TemplateScopeValues, esv1beta1.TemplateScopeEnv, esv1beta1.TemplateScopeProperties,
+			esv1beta1.TemplateScopeINI, esv1beta1.TemplateScopeTOML:
 			out[k.Key] = val
 		case esv1beta1.TemplateScopeKeysAndValues:
 			out[string(val)] = val
 		}
-		err = p.Exec(out, p.DataMap, k.TemplateAs, tpl.Target, p.TargetSecret)
+		err = p.exec(out, k.TemplateAs, tpl.Target)
 		if err != nil {
 			return err
 		}
@@ -113,7 +155,7 @@ func (p *Parser) MergeLiteral(_ context.Context, tpl esv1beta1.TemplateFrom) err
 	}
 	out := make(map[string][]byte)
 	out[*tpl.Literal] = []byte(*tpl.Literal)
-	return p.Exec(out, p.DataMap, esv1beta1.TemplateScopeKeysAndValues, tpl.Target, p.TargetSecret)
+	return p.exec(out, esv1beta1.TemplateScopeKeysAndValues, tpl.Target)
 }
 
 func (p *Parser) MergeTemplateFrom(ctx context.Context, namespace string, template *esv1beta1.ExternalSecretTemplate) error {
@@ -143,13 +185,41 @@ func (p *Parser) MergeMap(tplMap map[string]string, target esv1beta1.TemplateTar
 	for k, v := range tplMap {
 		byteMap[k] = []byte(v)
 	}
-	err := p.Exec(byteMap, p.DataMap, esv1beta1.TemplateScopeValues, target, p.TargetSecret)
+	err := p.exec(byteMap, esv1beta1.TemplateScopeValues, target)
 	if err != nil {
 		return fmt.Errorf(errExecTpl, err)
 	}
 	return nil
 }
 
+// ApplyContentTypes converts the rendered data keys according to their content type.
+// Keys that have not been rendered, e.g. provider keys merged into the secret, are ignored.
+func (p *Parser) ApplyContentTypes(contentTypes map[string]esv1beta1.TemplateContentType) error {
+	for k, contentType := range contentTypes {
+		if _, ok := p.renderedKeys[k]; !ok {
+			continue
+		}
+		val := p.TargetSecret.Data[k]
+		switch contentType {
+		case esv1beta1.TemplateContentTypeText:
+			if !utf8.Valid(val) {
+				return fmt.Errorf(errContentText, k)
+			}
+		case esv1beta1.TemplateContentTypeBinary:
+		case esv1beta1.TemplateContentTypeBase64:
+			// whitespace is ignored, so long values can be rendered as multiple lines
+			out, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(val)), ""))
+			if err != nil {
+				return fmt.Errorf(errContentBase64, k, err)
+			}
+			p.TargetSecret.Data[k] = out
+		default:
+			return fmt.Errorf(errContentType, contentType, k)
+		}
+	}
+	return nil
+}
+
 func GetManagedAnnotationKeys(secret *v1.Secret, fieldOwner string) ([]string, error) {
 	return getManagedFieldKeys(secret, fieldOwner, func(fields map[string]interface{}) []string {
 		metadataFields, exists := fields["f:metadata"]
@@ -0,0 +1,140 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templating
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/template/v2"
+)
+
+func TestApplyContentTypes(t *testing.T) {
+	binary := []byte{0xca, 0xfe, 0x00, 0xba, 0xbe}
+	tbl := []struct {
+		name string
+		// data is written as rendered, existing keys are not.
+		data         map[string][]byte
+		existing     map[string][]byte
+		contentTypes map[string]esv1beta1.TemplateContentType
+		want         map[string][]byte
+		expErr       string
+	}{
+		{
+			name: "base64 is decoded",
+			data: map[string][]byte{
+				"keystore": []byte("yv4Aur4="),
+				"other":    []byte("yv4Aur4="),
+			},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"keystore": esv1beta1.TemplateContentTypeBase64},
+			want: map[string][]byte{
+				"keystore": binary,
+				"other":    []byte("yv4Aur4="),
+			},
+		},
+		{
+			name:         "base64 spanning multiple lines",
+			data:         map[string][]byte{"keystore": []byte("yv4A\nur4=\n")},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"keystore": esv1beta1.TemplateContentTypeBase64},
+			want:         map[string][]byte{"keystore": binary},
+		},
+		{
+			name:         "binary is kept",
+			data:         map[string][]byte{"keystore": binary},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"keystore": esv1beta1.TemplateContentTypeBinary},
+			want:         map[string][]byte{"keystore": binary},
+		},
+		{
+			name:         "valid text",
+			data:         map[string][]byte{"config": []byte("grüße")},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"config": esv1beta1.TemplateContentTypeText},
+			want:         map[string][]byte{"config": []byte("grüße")},
+		},
+		{
+			name:         "keys that have not been rendered are ignored",
+			data:         map[string][]byte{"keystore": []byte("yv4Aur4=")},
+			existing:     map[string][]byte{"provider": []byte("yv4Aur4=")},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"keystore": esv1beta1.TemplateContentTypeBase64, "provider": esv1beta1.TemplateContentTypeBase64},
+			want: map[string][]byte{
+				"keystore": binary,
+				"provider": []byte("yv4Aur4="),
+			},
+		},
+		{
+			name:         "missing keys are ignored",
+			data:         map[string][]byte{},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"keystore": esv1beta1.TemplateContentTypeBase64},
+			want:         map[string][]byte{},
+		},
+		{
+			name:         "invalid text",
+			data:         map[string][]byte{"config": binary},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"config": esv1beta1.TemplateContentTypeText},
+			expErr:       "rendered value of key config is not valid UTF-8",
+		},
+		{
+			name:         "invalid base64",
+			data:         map[string][]byte{"keystore": []byte("not base64")},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"keystore": esv1beta1.TemplateContentTypeBase64},
+			expErr:       "could not decode rendered value of key keystore",
+		},
+		{
+			name:         "unknown content type",
+			data:         map[string][]byte{"keystore": binary},
+			contentTypes: map[string]esv1beta1.TemplateContentType{"keystore": "Foo"},
+			expErr:       `unknown content type "Foo" of key keystore`,
+		},
+	}
+	for i := range tbl {
+		row := tbl[i]
+		t.Run(row.name, func(t *testing.T) {
+			p := &Parser{
+				TargetSecret: &v1.Secret{Data: make(map[string][]byte)},
+			}
+			for k, v := range row.existing {
+				p.TargetSecret.Data[k] = v
+			}
+			p.SetData(row.data)
+			err := p.ApplyContentTypes(row.contentTypes)
+			if row.expErr != "" {
+				assert.ErrorContains(t, err, row.expErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, row.want, p.TargetSecret.Data)
+		})
+	}
+}
+
+func TestRenderedKeys(t *testing.T) {
+	p := &Parser{
+		Exec:         template.Execute,
+		DataMap:      map[string][]byte{"keystore": []byte("yv4Aur4=")},
+		TargetSecret: &v1.Secret{Data: map[string][]byte{"merged": []byte("yv4Aur4=")}},
+	}
+	require.NoError(t, p.MergeMap(map[string]string{"rendered": "{{ .keystore }}"}, esv1beta1.TemplateTargetData))
+	require.NoError(t, p.ApplyContentTypes(map[string]esv1beta1.TemplateContentType{
+		"rendered": esv1beta1.TemplateContentTypeBase64,
+		"merged":   esv1beta1.TemplateContentTypeBase64,
+	}))
+	assert.Equal(t, map[string][]byte{
+		"rendered": {0xca, 0xfe, 0x00, 0xba, 0xbe},
+		"merged":   []byte("yv4Aur4="),
+	}, p.TargetSecret.Data)
+}
@@ -42,6 +42,7 @@ import (
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	smmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
 	"github.com/external-secrets/external-secrets/pkg/provider/azure/keyvault"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
 
 type Generator struct {
@@ -70,20 +71,20 @@ const (
 // * access tokens are scoped to a specific repository or action (pull,push)
 // * refresh tokens can are scoped to whatever policy is attached to the identity that creates the acr refresh token
 // details can be found here: https://github.com/Azure/acr/blob/main/docs/AAD-OAuth.md#overview
-func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, crClient client.Client, namespace string) (map[string][]byte, error) {
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, crClient client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
 	cfg, err := ctrlcfg.GetConfig()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	kubeClient, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	g.clientSecretCreds = func(tenantID, clientID, clientSecret string, options *azidentity.ClientSecretCredentialOptions) (TokenGetter, error) {
 		return azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, options)
 	}
 
-	return g.generate(
+	data, err := g.generate(
 		ctx,
 		jsonSpec,
 		crClient,
@@ -91,6 +92,11 @@ func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON,
 		kubeClient,
 		fetchACRAccessToken,
 		fetchACRRefreshToken)
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
 }
 
 func (g *Generator) generate(
@@ -250,6 +256,10 @@ func accessTokenForWorkloadIdentity(ctx context.Context, crClient client.Client,
 		}
 		return tp.OAuthToken(), nil
 	}
+	// ClusterGenerators may reference service accounts in other namespaces.
+	if resolvers.GeneratorStoreKind(ctx) == v1beta1.ClusterSecretStoreKind && serviceAccountRef.Namespace != nil {
+		namespace = *serviceAccountRef.Namespace
+	}
 	var sa corev1.ServiceAccount
 	err := crClient.Get(ctx, types.NamespacedName{
 		Name:      serviceAccountRef.Name,
@@ -332,8 +342,12 @@ func (g *Generator) accessTokenForServicePrincipal(ctx context.Context, crClient
 }
 
 // secretKeyRef fetches a secret key.
+// ClusterGenerators may reference secrets in other namespaces.
 func secretKeyRef(ctx context.Context, crClient client.Client, namespace string, secretRef smmeta.SecretKeySelector) (string, error) {
 	var secret corev1.Secret
+	if resolvers.GeneratorStoreKind(ctx) == v1beta1.ClusterSecretStoreKind && secretRef.Namespace != nil {
+		namespace = *secretRef.Namespace
+	}
 	ref := types.NamespacedName{
 		Namespace: namespace,
 		Name:      secretRef.Name,
@@ -0,0 +1,259 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	tpl "text/template"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/resolver"
+	"github.com/external-secrets/external-secrets/pkg/template/v2"
+)
+
+type Generator struct{}
+
+// state holds the states of the invoked generators by their name,
+// so they can be cleaned up by the respective generator.
+type state map[string]*apiextensions.JSON
+
+const (
+	errNoSpec          = "no config spec provided"
+	errParseSpec       = "unable to parse spec: %w"
+	errParseState      = "unable to parse state: %w"
+	errNoGenerators    = "no generators configured"
+	errNoTemplate      = "no template configured"
+	errNoName          = "generator %d has no name"
+	errDuplicateName   = "duplicate generator name %q"
+	errRefAndInline    = "generator %q must either have a generatorRef or an inline generator"
+	errNested          = "generator %q: composite generators can not be nested"
+	errInlineSpec      = "generator %q: %w"
+	errGetGenerator    = "unable to get generator %q: %w"
+	errGenerate        = "unable to generate values of %q: %w"
+	errParseTemplate   = "unable to parse template of key %q: %w"
+	errExecuteTemplate = "unable to execute template of key %q: %w"
+	errUnknownGen      = "generator %q was removed from the spec"
+	errCleanup         = "unable to clean up values of %q: %w"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	spec, err := parseSpec(jsonSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make(map[string]map[string]string, len(spec.Generators))
+	states := make(state)
+	for i := range spec.Generators {
+		src := &spec.Generators[i]
+		data, genState, err := generate(ctx, src, kube, namespace)
+		if genState != nil {
+			states[src.Name] = genState
+		}
+		if err != nil {
+			// the states gathered so far are returned along with the error,
+			// so the values generated so far are cleaned up by the caller.
+			return nil, states.providerState(), err
+		}
+		values[src.Name] = make(map[string]string, len(data))
+		for k, v := range data {
+			values[src.Name][k] = string(v)
+		}
+	}
+
+	out, err := render(spec.Template, values)
+	if err != nil {
+		return nil, states.providerState(), err
+	}
+	return out, states.providerState(), nil
+}
+
+// providerState returns the states as generator state, or nil if there are none.
+func (s state) providerState() genv1alpha1.GeneratorProviderState {
+	if len(s) == 0 {
+		return nil
+	}
+	// a map of raw JSON messages can always be marshaled.
+	raw, _ := json.Marshal(s)
+	return &apiextensions.JSON{Raw: raw}
+}
+
+// Cleanup lets every invoked generator clean up its previous values.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previous genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	if previous == nil {
+		return nil
+	}
+	spec, err := parseSpec(jsonSpec)
+	if err != nil {
+		return err
+	}
+	var states state
+	if err := json.Unmarshal(previous.Raw, &states); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	return errors.Join(g.cleanupStates(ctx, spec, states, kube, namespace)...)
+}
+
+func (g *Generator) cleanupStates(ctx context.Context, spec *genv1alpha1.CompositeSpec, states state, kube client.Client, namespace string) []error {
+	var errs []error
+	for name, genState := range states {
+		src := findGenerator(spec, name)
+		if src == nil {
+			errs = append(errs, fmt.Errorf(errUnknownGen, name))
+			continue
+		}
+		genDef, err := definition(ctx, src, kube, namespace)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(errCleanup, name, err))
+			continue
+		}
+		gen, err := genv1alpha1.GetGenerator(genDef)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(errCleanup, name, err))
+			continue
+		}
+		if err := gen.Cleanup(generatorContext(ctx, src), genDef, genState, kube, namespace); err != nil {
+			errs = append(errs, fmt.Errorf(errCleanup, name, err))
+		}
+	}
+	return errs
+}
+
+func generate(ctx context.Context, src *genv1alpha1.CompositeGenerator, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	genDef, err := definition(ctx, src, kube, namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGetGenerator, src.Name, err)
+	}
+	gen, err := genv1alpha1.GetGenerator(genDef)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGetGenerator, src.Name, err)
+	}
+	// the state of a failed generation is kept, it may still need to be cleaned up.
+	data, genState, err := gen.Generate(generatorContext(ctx, src), genDef, kube, namespace)
+	if err != nil {
+		return nil, genState, fmt.Errorf(errGenerate, src.Name, err)
+	}
+	return data, genState, nil
+}
+
+// generatorContext returns the context a generator of the composite is called with.
+// Inline generators resolve references like the composite itself,
+// referenced generators according to their own kind.
+func generatorContext(ctx context.Context, src *genv1alpha1.CompositeGenerator) context.Context {
+	if src.GeneratorRef != nil {
+		return resolver.Context(ctx, src.GeneratorRef)
+	}
+	return ctx
+}
+
+// definition returns the JSON of a referenced generator
+// or builds it from the inline spec.
+func definition(ctx context.Context, src *genv1alpha1.CompositeGenerator, kube client.Client, namespace string) (*apiextensions.JSON, error) {
+	if src.GeneratorRef != nil {
+		if src.GeneratorRef.Kind == genv1alpha1.CompositeKind {
+			return nil, fmt.Errorf(errNested, src.Name)
+		}
+		return resolver.Definition(ctx, kube, namespace, src.GeneratorRef)
+	}
+
+	spec, err := src.Generator.GetSpec(src.Kind)
+	if err != nil {
+		return nil, fmt.Errorf(errInlineSpec, src.Name, err)
+	}
+	gen := map[string]any{
+		"apiVersion": genv1alpha1.SchemeGroupVersion.String(),
+		"kind":       string(src.Kind),
+		"metadata": map[string]any{
+			"name": src.Name,
+		},
+		"spec": spec,
+	}
+	jsonRes, err := json.Marshal(gen)
+	if err != nil {
+		return nil, err
+	}
+	return &apiextensions.JSON{Raw: jsonRes}, nil
+}
+
+func render(templates map[string]string, values map[string]map[string]string) (map[string][]byte, error) {
+	out := make(map[string][]byte, len(templates))
+	for key, text := range templates {
+		t, err := tpl.New(key).
+			Funcs(template.FuncMap()).
+			Option("missingkey=error").
+			Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf(errParseTemplate, key, err)
+		}
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, values); err != nil {
+			return nil, fmt.Errorf(errExecuteTemplate, key, err)
+		}
+		out[key] = buf.Bytes()
+	}
+	return out, nil
+}
+
+func findGenerator(spec *genv1alpha1.CompositeSpec, name string) *genv1alpha1.CompositeGenerator {
+	for i := range spec.Generators {
+		if spec.Generators[i].Name == name {
+			return &spec.Generators[i]
+		}
+	}
+	return nil
+}
+
+func parseSpec(jsonSpec *apiextensions.JSON) (*genv1alpha1.CompositeSpec, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	var res genv1alpha1.Composite
+	if err := yaml.Unmarshal(jsonSpec.Raw, &res); err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := &res.Spec
+	if len(spec.Generators) == 0 {
+		return nil, errors.New(errNoGenerators)
+	}
+	if len(spec.Template) == 0 {
+		return nil, errors.New(errNoTemplate)
+	}
+	names := make(map[string]struct{}, len(spec.Generators))
+	for i, src := range spec.Generators {
+		if src.Name == "" {
+			return nil, fmt.Errorf(errNoName, i)
+		}
+		if _, ok := names[src.Name]; ok {
+			return nil, fmt.Errorf(errDuplicateName, src.Name)
+		}
+		names[src.Name] = struct{}{}
+		if (src.GeneratorRef == nil) == (src.Generator == nil) {
+			return nil, fmt.Errorf(errRefAndInline, src.Name)
+		}
+	}
+	return spec, nil
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.CompositeKind, &Generator{})
+}
@@ -0,0 +1,312 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"text/template"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/password"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+// Generator creates database users, the database specific
+// parts are implemented by a dialect.
+type Generator struct {
+	newDialect func(data []byte) (dialect, error)
+}
+
+// dialect implements the database specific parts of a user generator.
+type dialect interface {
+	// userSpec returns the configuration shared by all databases.
+	userSpec() *genv1alpha1.DatabaseUserSpec
+	defaultPort() int32
+	driverName() string
+	dataSourceName(username, password string) string
+	grantData(username string) map[string]string
+	userExists(username string) statement
+	createUser(username, password string) statement
+	dropUser(username string) []statement
+	lockUser(username string) []statement
+}
+
+// statement is a SQL statement with its arguments.
+type statement struct {
+	query string
+	args  []any
+}
+
+// state identifies the user of a previous generation,
+// so it can be removed once it is no longer used.
+type state struct {
+	Username string `json:"username"`
+	// Incomplete is set for users whose grants could not be applied
+	// and which could not be dropped right away.
+	// They are dropped on cleanup regardless of the cleanup policy.
+	Incomplete bool `json:"incomplete,omitempty"`
+}
+
+type openFunc func(driverName, dataSourceName string) (*sql.DB, error)
+
+const (
+	defaultUsernamePrefix = "es_"
+	defaultPasswordLength = 24
+	usernameSuffixLength  = 8
+	usernameChars         = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	errNoSpec        = "no config spec provided"
+	errParseSpec     = "unable to parse spec: %w"
+	errParseState    = "unable to parse state: %w"
+	errGetPassword   = "unable to get admin password: %w"
+	errConnect       = "unable to connect to database: %w"
+	errGenerate      = "unable to generate credentials: %w"
+	errParseGrant    = "unable to parse grant statement %d: %w"
+	errRenderGrant   = "unable to render grant statement %d: %w"
+	errCreateUser    = "unable to create user: %w"
+	errGrant         = "unable to execute grant statement %d: %w"
+	errDropUser      = "unable to drop incomplete user %q, dropping it is retried on cleanup: %w"
+	errCleanupUser   = "unable to clean up user %q: %w"
+	errUnknownPolicy = "unknown cleanup policy: %q"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, sql.Open)
+}
+
+// Cleanup drops or locks the user of a previous generation.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previous genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	if previous == nil {
+		return nil
+	}
+	return g.cleanup(ctx, jsonSpec, previous, kube, namespace, sql.Open)
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, open openFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	d, err := g.parseSpec(jsonSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	spec := d.userSpec()
+
+	username, err := generateUsername(spec.UsernamePrefix)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGenerate, err)
+	}
+	pass, err := generatePassword(ctx, spec.Password)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGenerate, err)
+	}
+	// render the grants before the user is created,
+	// so invalid templates do not leave a user behind.
+	grants, err := renderGrants(spec.Grants, d.grantData(username))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := connect(ctx, d, kube, namespace, open)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer db.Close()
+
+	if err := exec(ctx, db, d.createUser(username, pass)); err != nil {
+		return nil, nil, fmt.Errorf(errCreateUser, err)
+	}
+	for i, grant := range grants {
+		if err := exec(ctx, db, statement{query: grant}); err != nil {
+			// do not leave a half configured user behind. If that fails
+			// the state is returned, so the user is dropped on cleanup.
+			for _, stmt := range d.dropUser(username) {
+				if dropErr := exec(ctx, db, stmt); dropErr != nil {
+					raw, _ := json.Marshal(&state{Username: username, Incomplete: true})
+					return nil, &apiextensions.JSON{Raw: raw}, errors.Join(fmt.Errorf(errGrant, i, err), fmt.Errorf(errDropUser, username, dropErr))
+				}
+			}
+			return nil, nil, fmt.Errorf(errGrant, i, err)
+		}
+	}
+
+	port := spec.Port
+	if port == 0 {
+		port = d.defaultPort()
+	}
+	return map[string][]byte{
+		"host":     []byte(spec.Host),
+		"port":     []byte(strconv.Itoa(int(port))),
+		"database": []byte(spec.Database),
+		"username": []byte(username),
+		"password": []byte(pass),
+	}, newState(username), nil
+}
+
+func (g *Generator) cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previous genv1alpha1.GeneratorProviderState, kube client.Client, namespace string, open openFunc) error {
+	d, err := g.parseSpec(jsonSpec)
+	if err != nil {
+		return err
+	}
+	var prev state
+	if err := json.Unmarshal(previous.Raw, &prev); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	if prev.Username == "" {
+		return nil
+	}
+
+	policy := d.userSpec().CleanupPolicy
+	// incomplete users have never been handed out, they are dropped regardless of the policy.
+	if prev.Incomplete {
+		policy = genv1alpha1.DatabaseUserCleanupPolicyDrop
+	}
+	var stmts []statement
+	switch policy {
+	case genv1alpha1.DatabaseUserCleanupPolicyRetain:
+		return nil
+	case genv1alpha1.DatabaseUserCleanupPolicyLock:
+		stmts = d.lockUser(prev.Username)
+	case genv1alpha1.DatabaseUserCleanupPolicyDrop, "":
+		stmts = d.dropUser(prev.Username)
+	default:
+		return fmt.Errorf(errUnknownPolicy, policy)
+	}
+
+	db, err := connect(ctx, d, kube, namespace, open)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// the user may have been removed manually in the meantime.
+	var exists bool
+	query := d.userExists(prev.Username)
+	if err := db.QueryRowContext(ctx, query.query, query.args...).Scan(&exists); err != nil {
+		return fmt.Errorf(errCleanupUser, prev.Username, err)
+	}
+	if !exists {
+		return nil
+	}
+	for _, stmt := range stmts {
+		if err := exec(ctx, db, stmt); err != nil {
+			return fmt.Errorf(errCleanupUser, prev.Username, err)
+		}
+	}
+	return nil
+}
+
+func (g *Generator) parseSpec(jsonSpec *apiextensions.JSON) (dialect, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	d, err := g.newDialect(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	return d, nil
+}
+
+func connect(ctx context.Context, d dialect, kube client.Client, namespace string, open openFunc) (*sql.DB, error) {
+	spec := d.userSpec()
+	adminPassword, err := resolvers.SecretKeyRef(ctx, kube, resolvers.GeneratorStoreKind(ctx), namespace, &spec.Auth.PasswordSecretRef)
+	if err != nil {
+		return nil, fmt.Errorf(errGetPassword, err)
+	}
+	db, err := open(d.driverName(), d.dataSourceName(spec.Auth.Username, adminPassword))
+	if err != nil {
+		return nil, fmt.Errorf(errConnect, err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf(errConnect, err)
+	}
+	return db, nil
+}
+
+func exec(ctx context.Context, db *sql.DB, stmt statement) error {
+	_, err := db.ExecContext(ctx, stmt.query, stmt.args...)
+	return err
+}
+
+func renderGrants(grants []string, data map[string]string) ([]string, error) {
+	out := make([]string, 0, len(grants))
+	for i, grant := range grants {
+		tpl, err := template.New("grant").Option("missingkey=error").Parse(grant)
+		if err != nil {
+			return nil, fmt.Errorf(errParseGrant, i, err)
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf(errRenderGrant, i, err)
+		}
+		out = append(out, buf.String())
+	}
+	return out, nil
+}
+
+func generateUsername(prefix string) (string, error) {
+	if prefix == "" {
+		prefix = defaultUsernamePrefix
+	}
+	suffix := make([]byte, usernameSuffixLength)
+	for i := range suffix {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(usernameChars))))
+		if err != nil {
+			return "", err
+		}
+		suffix[i] = usernameChars[n.Int64()]
+	}
+	return prefix + string(suffix), nil
+}
+
+// generatePassword uses the password generator, symbols are omitted by default
+// so the password can be used in connection strings without escaping.
+func generatePassword(ctx context.Context, spec *genv1alpha1.PasswordSpec) (string, error) {
+	if spec == nil {
+		spec = &genv1alpha1.PasswordSpec{
+			Length:  defaultPasswordLength,
+			Symbols: ptr.To(0),
+		}
+	}
+	raw, err := json.Marshal(&genv1alpha1.Password{Spec: *spec})
+	if err != nil {
+		return "", err
+	}
+	data, _, err := (&password.Generator{}).Generate(ctx, &apiextensions.JSON{Raw: raw}, nil, "")
+	if err != nil {
+		return "", err
+	}
+	return string(data["password"]), nil
+}
+
+func newState(username string) genv1alpha1.GeneratorProviderState {
+	raw, _ := json.Marshal(&state{Username: username})
+	return &apiextensions.JSON{Raw: raw}
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.PostgreSQLUserKind, &Generator{newDialect: newPostgreSQL})
+	genv1alpha1.Register(genv1alpha1.MySQLUserKind, &Generator{newDialect: newMySQL})
+}
@@ -0,0 +1,122 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/go-sql-driver/mysql"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+const (
+	mySQLDefaultPort     = 3306
+	mySQLDefaultUserHost = "%"
+)
+
+// tls modes of the spec mapped to the values of the driver.
+var mySQLTLSModes = map[string]string{
+	"":            "true",
+	"required":    "true",
+	"disabled":    "false",
+	"skip-verify": "skip-verify",
+	"preferred":   "preferred",
+}
+
+type mySQL struct {
+	spec genv1alpha1.MySQLUserSpec
+}
+
+func newMySQL(data []byte) (dialect, error) {
+	var res genv1alpha1.MySQLUser
+	if err := yaml.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &mySQL{spec: res.Spec}, nil
+}
+
+func (m *mySQL) userSpec() *genv1alpha1.DatabaseUserSpec {
+	return &m.spec.DatabaseUserSpec
+}
+
+func (m *mySQL) defaultPort() int32 {
+	return mySQLDefaultPort
+}
+
+func (m *mySQL) driverName() string {
+	return "mysql"
+}
+
+// dataSourceName enables client side interpolation of parameters,
+// as account management statements can not be prepared.
+func (m *mySQL) dataSourceName(username, password string) string {
+	port := m.spec.Port
+	if port == 0 {
+		port = mySQLDefaultPort
+	}
+	cfg := mysql.NewConfig()
+	cfg.User = username
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(m.spec.Host, strconv.Itoa(int(port)))
+	cfg.DBName = m.spec.Database
+	cfg.TLSConfig = mySQLTLSModes[m.spec.TLS]
+	cfg.InterpolateParams = true
+	return cfg.FormatDSN()
+}
+
+func (m *mySQL) userHost() string {
+	if m.spec.UserHost == "" {
+		return mySQLDefaultUserHost
+	}
+	return m.spec.UserHost
+}
+
+func (m *mySQL) grantData(username string) map[string]string {
+	return map[string]string{
+		"Username": username,
+		"Database": m.spec.Database,
+		"UserHost": m.userHost(),
+	}
+}
+
+func (m *mySQL) userExists(username string) statement {
+	return statement{
+		query: "SELECT EXISTS (SELECT 1 FROM mysql.user WHERE User = ? AND Host = ?)",
+		args:  []any{username, m.userHost()},
+	}
+}
+
+func (m *mySQL) createUser(username, password string) statement {
+	return statement{
+		query: "CREATE USER ?@? IDENTIFIED BY ?",
+		args:  []any{username, m.userHost(), password},
+	}
+}
+
+func (m *mySQL) dropUser(username string) []statement {
+	return []statement{
+		{query: "DROP USER IF EXISTS ?@?", args: []any{username, m.userHost()}},
+	}
+}
+
+func (m *mySQL) lockUser(username string) []statement {
+	return []statement{
+		{query: "ALTER USER ?@? ACCOUNT LOCK", args: []any{username, m.userHost()}},
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/lib/pq"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+const (
+	postgreSQLDefaultPort     = 5432
+	postgreSQLDefaultDatabase = "postgres"
+	postgreSQLDefaultSSLMode  = "require"
+)
+
+type postgreSQL struct {
+	spec genv1alpha1.PostgreSQLUserSpec
+}
+
+func newPostgreSQL(data []byte) (dialect, error) {
+	var res genv1alpha1.PostgreSQLUser
+	if err := yaml.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &postgreSQL{spec: res.Spec}, nil
+}
+
+func (p *postgreSQL) userSpec() *genv1alpha1.DatabaseUserSpec {
+	return &p.spec.DatabaseUserSpec
+}
+
+func (p *postgreSQL) defaultPort() int32 {
+	return postgreSQLDefaultPort
+}
+
+func (p *postgreSQL) driverName() string {
+	return "postgres"
+}
+
+func (p *postgreSQL) dataSourceName(username, password string) string {
+	port := p.spec.Port
+	if port == 0 {
+		port = postgreSQLDefaultPort
+	}
+	database := p.spec.Database
+	if database == "" {
+		database = postgreSQLDefaultDatabase
+	}
+	sslMode := p.spec.SSLMode
+	if sslMode == "" {
+		sslMode = postgreSQLDefaultSSLMode
+	}
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(username, password),
+		Host:     net.JoinHostPort(p.spec.Host, strconv.Itoa(int(port))),
+		Path:     "/" + database,
+		RawQuery: url.Values{"sslmode": []string{sslMode}}.Encode(),
+	}
+	return dsn.String()
+}
+
+func (p *postgreSQL) grantData(username string) map[string]string {
+	return map[string]string{
+		"Username": username,
+		"Database": p.spec.Database,
+	}
+}
+
+func (p *postgreSQL) userExists(username string) statement {
+	return statement{
+		query: "SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)",
+		args:  []any{username},
+	}
+}
+
+// utility statements do not support parameters,
+// so the values are quoted instead.
+func (p *postgreSQL) createUser(username, password string) statement {
+	return statement{
+		query: "CREATE ROLE " + pq.QuoteIdentifier(username) + " WITH LOGIN PASSWORD " + pq.QuoteLiteral(password),
+	}
+}
+
+// dropUser hands over objects owned by the user to the admin user
+// and revokes its privileges, otherwise the role can not be dropped.
+func (p *postgreSQL) dropUser(username string) []statement {
+	user := pq.QuoteIdentifier(username)
+	return []statement{
+		{query: "REASSIGN OWNED BY " + user + " TO CURRENT_USER"},
+		{query: "DROP OWNED BY " + user},
+		{query: "DROP ROLE IF EXISTS " + user},
+	}
+}
+
+func (p *postgreSQL) lockUser(username string) []statement {
+	return []statement{
+		{query: "ALTER ROLE " + pq.QuoteIdentifier(username) + " NOLOGIN"},
+	}
+}
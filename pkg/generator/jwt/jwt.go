@@ -0,0 +1,313 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwt
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	tpl "text/template"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/template/v2"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	defaultExpiration = time.Hour
+
+	keyToken      = "token"
+	keyExpiration = "expirationTimestamp"
+
+	pemTypePrivateKey = "PRIVATE KEY"
+
+	errNoSpec          = "no config spec provided"
+	errParseSpec       = "unable to parse spec: %w"
+	errGetKey          = "unable to get signing key: %w"
+	errUnknownAlgo     = "unknown algorithm: %q"
+	errEmptySecret     = "shared secret must not be empty"
+	errNoPrivateKey    = "no private key found in signing key"
+	errParseJWK        = "unable to parse JWK: %w"
+	errKeyMismatch     = "algorithm %s requires %s key"
+	errExpiration      = "expiration must be positive, got %s"
+	errParseClaims     = "unable to parse claims: %w"
+	errParseTemplate   = "unable to parse template of claim %q: %w"
+	errExecuteTemplate = "unable to execute template of claim %q: %w"
+	errSign            = "unable to sign token: %w"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	data, err := g.generate(ctx, jsonSpec, kube, namespace, time.Now())
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, now time.Time) (map[string][]byte, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := res.Spec
+
+	expiration := defaultExpiration
+	if spec.Expiration != nil {
+		expiration = spec.Expiration.Duration
+	}
+	if expiration <= 0 {
+		return nil, fmt.Errorf(errExpiration, expiration)
+	}
+	expiresAt := now.Add(expiration).Truncate(time.Second)
+
+	keyData, err := resolvers.SecretKeyRef(ctx, kube, resolvers.GeneratorStoreKind(ctx), namespace, &spec.KeySecretRef)
+	if err != nil {
+		return nil, fmt.Errorf(errGetKey, err)
+	}
+	method, key, keyID, err := signingKey(spec.Algorithm, keyData)
+	if err != nil {
+		return nil, err
+	}
+	if spec.KeyID != "" {
+		keyID = spec.KeyID
+	}
+
+	claims, err := buildClaims(&spec, namespace, now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	token := gojwt.NewWithClaims(method, claims)
+	if keyID != "" {
+		token.Header["kid"] = keyID
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return nil, fmt.Errorf(errSign, err)
+	}
+
+	return map[string][]byte{
+		keyToken:      []byte(signed),
+		keyExpiration: []byte(expiresAt.UTC().Format(time.RFC3339)),
+	}, nil
+}
+
+// signingKey returns the signing method and the key for the given algorithm
+// along with the key id of the key, if any.
+func signingKey(algorithm genv1alpha1.JWTAlgorithm, data string) (gojwt.SigningMethod, any, string, error) {
+	if algorithm == genv1alpha1.JWTAlgorithmHS256 {
+		if data == "" {
+			return nil, nil, "", errors.New(errEmptySecret)
+		}
+		return gojwt.SigningMethodHS256, []byte(data), "", nil
+	}
+
+	var method gojwt.SigningMethod
+	switch algorithm {
+	case genv1alpha1.JWTAlgorithmRS256:
+		method = gojwt.SigningMethodRS256
+	case genv1alpha1.JWTAlgorithmES256:
+		method = gojwt.SigningMethodES256
+	case genv1alpha1.JWTAlgorithmEdDSA:
+		method = gojwt.SigningMethodEdDSA
+	default:
+		return nil, nil, "", fmt.Errorf(errUnknownAlgo, algorithm)
+	}
+	key, keyID, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if algorithm == genv1alpha1.JWTAlgorithmRS256 {
+			return method, k, keyID, nil
+		}
+	case *ecdsa.PrivateKey:
+		if algorithm == genv1alpha1.JWTAlgorithmES256 && k.Curve == elliptic.P256() {
+			return method, k, keyID, nil
+		}
+	case ed25519.PrivateKey:
+		if algorithm == genv1alpha1.JWTAlgorithmEdDSA {
+			return method, k, keyID, nil
+		}
+	}
+	return nil, nil, "", fmt.Errorf(errKeyMismatch, algorithm, requiredKey(algorithm))
+}
+
+func requiredKey(algorithm genv1alpha1.JWTAlgorithm) string {
+	switch algorithm {
+	case genv1alpha1.JWTAlgorithmRS256:
+		return "an RSA"
+	case genv1alpha1.JWTAlgorithmES256:
+		return "an ECDSA P-256"
+	default:
+		return "an Ed25519"
+	}
+}
+
+// parsePrivateKey parses a private JWK or the first private key
+// found in PEM encoded data.
+func parsePrivateKey(data string) (any, string, error) {
+	if strings.HasPrefix(strings.TrimSpace(data), "{") {
+		k, err := jwk.ParseKey([]byte(data))
+		if err != nil {
+			return nil, "", fmt.Errorf(errParseJWK, err)
+		}
+		var key any
+		if err := k.Raw(&key); err != nil {
+			return nil, "", fmt.Errorf(errParseJWK, err)
+		}
+		return key, k.KeyID(), nil
+	}
+
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, "", errors.New(errNoPrivateKey)
+		}
+		if !strings.HasSuffix(block.Type, pemTypePrivateKey) {
+			continue
+		}
+		key, err := template.ParsePrivateKey(block.Bytes)
+		if err != nil {
+			return nil, "", err
+		}
+		return key, "", nil
+	}
+}
+
+// buildClaims renders the custom claims and sets the registered claims on top.
+func buildClaims(spec *genv1alpha1.JWTSpec, namespace string, now, expiresAt time.Time) (gojwt.MapClaims, error) {
+	claims := gojwt.MapClaims{}
+	if spec.Claims != nil {
+		if err := json.Unmarshal(spec.Claims.Raw, &claims); err != nil {
+			return nil, fmt.Errorf(errParseClaims, err)
+		}
+	}
+	if spec.Issuer != "" {
+		claims["iss"] = spec.Issuer
+	}
+	if spec.Subject != "" {
+		claims["sub"] = spec.Subject
+	}
+	switch len(spec.Audience) {
+	case 0:
+	case 1:
+		claims["aud"] = spec.Audience[0]
+	default:
+		claims["aud"] = spec.Audience
+	}
+
+	data := map[string]string{
+		"namespace": namespace,
+	}
+	for name, value := range claims {
+		rendered, err := renderClaim(name, value, data)
+		if err != nil {
+			return nil, err
+		}
+		claims[name] = rendered
+	}
+
+	claims["iat"] = now.Unix()
+	claims["nbf"] = now.Unix()
+	claims["exp"] = expiresAt.Unix()
+	return claims, nil
+}
+
+// renderClaim renders all strings of a claim value as templates.
+func renderClaim(name string, value any, data map[string]string) (any, error) {
+	switch v := value.(type) {
+	case string:
+		if !strings.Contains(v, "{{") {
+			return v, nil
+		}
+		t, err := tpl.New(name).
+			Funcs(template.FuncMap()).
+			Option("missingkey=error").
+			Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf(errParseTemplate, name, err)
+		}
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf(errExecuteTemplate, name, err)
+		}
+		return buf.String(), nil
+	case []string:
+		out := make([]any, len(v))
+		for i := range v {
+			rendered, err := renderClaim(name, v[i], data)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = rendered
+		}
+		return out, nil
+	case []any:
+		for i := range v {
+			rendered, err := renderClaim(name, v[i], data)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = rendered
+		}
+		return v, nil
+	case map[string]any:
+		for k := range v {
+			rendered, err := renderClaim(name, v[k], data)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = rendered
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+func parseSpec(data []byte) (*genv1alpha1.JWT, error) {
+	var spec genv1alpha1.JWT
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.JWTKind, &Generator{})
+}
@@ -0,0 +1,273 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const testNamespace = "default"
+
+func TestGenerate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ec384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwkKey, err := jwk.FromRaw(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := jwkKey.Set(jwk.KeyIDKey, "jwk-kid"); err != nil {
+		t.Fatal(err)
+	}
+	jwkJSON, err := json.Marshal(jwkKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "keys", Namespace: testNamespace},
+		Data: map[string][]byte{
+			"rsa":    pemKey(t, rsaKey),
+			"pkcs1":  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			"ec":     pemKey(t, ecKey),
+			"ec384":  pemKey(t, ec384Key),
+			"ed":     pemKey(t, edKey),
+			"jwk":    jwkJSON,
+			"secret": []byte("s3cr3t"),
+			"empty":  {},
+		},
+	}).Build()
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name       string
+		spec       string
+		verifyKey  any
+		wantClaims map[string]any
+		wantKeyID  string
+		wantExp    time.Time
+		wantErr    bool
+	}{
+		{
+			name:    "no json spec should result in error",
+			wantErr: true,
+		},
+		{
+			name:      "RS256 with registered claims",
+			spec:      `{"algorithm":"RS256","keySecretRef":{"name":"keys","key":"rsa"},"issuer":"eso","subject":"system:{{ .namespace }}","audience":["api"]}`,
+			verifyKey: &rsaKey.PublicKey,
+			wantClaims: map[string]any{
+				"iss": "eso",
+				"sub": "system:default",
+				"aud": "api",
+				"iat": float64(now.Unix()),
+				"nbf": float64(now.Unix()),
+				"exp": float64(now.Add(time.Hour).Unix()),
+			},
+			wantExp: now.Add(time.Hour),
+		},
+		{
+			name:      "RS256 with PKCS#1 key and multiple audiences",
+			spec:      `{"algorithm":"RS256","keySecretRef":{"name":"keys","key":"pkcs1"},"audience":["a","b"],"expiration":"5m"}`,
+			verifyKey: &rsaKey.PublicKey,
+			wantClaims: map[string]any{
+				"aud": []any{"a", "b"},
+				"exp": float64(now.Add(5 * time.Minute).Unix()),
+			},
+			wantExp: now.Add(5 * time.Minute),
+		},
+		{
+			name:      "ES256 with custom claims",
+			spec:      `{"algorithm":"ES256","keySecretRef":{"name":"keys","key":"ec"},"keyID":"my-kid","issuer":"eso","claims":{"iss":"ignored","scope":["read","{{ .namespace }}"],"admin":true,"tenant":{"id":"{{ upper .namespace }}"}}}`,
+			verifyKey: &ecKey.PublicKey,
+			wantKeyID: "my-kid",
+			wantClaims: map[string]any{
+				"iss":    "eso",
+				"scope":  []any{"read", "default"},
+				"admin":  true,
+				"tenant": map[string]any{"id": "DEFAULT"},
+			},
+			wantExp: now.Add(time.Hour),
+		},
+		{
+			name:      "ES256 with JWK",
+			spec:      `{"algorithm":"ES256","keySecretRef":{"name":"keys","key":"jwk"}}`,
+			verifyKey: &ecKey.PublicKey,
+			wantKeyID: "jwk-kid",
+			wantExp:   now.Add(time.Hour),
+		},
+		{
+			name:      "EdDSA",
+			spec:      `{"algorithm":"EdDSA","keySecretRef":{"name":"keys","key":"ed"},"subject":"foo"}`,
+			verifyKey: edKey.Public(),
+			wantClaims: map[string]any{
+				"sub": "foo",
+			},
+			wantExp: now.Add(time.Hour),
+		},
+		{
+			name:      "HS256",
+			spec:      `{"algorithm":"HS256","keySecretRef":{"name":"keys","key":"secret"},"subject":"foo"}`,
+			verifyKey: []byte("s3cr3t"),
+			wantClaims: map[string]any{
+				"sub": "foo",
+			},
+			wantExp: now.Add(time.Hour),
+		},
+		{
+			name:    "HS256 with empty secret should result in error",
+			spec:    `{"algorithm":"HS256","keySecretRef":{"name":"keys","key":"empty"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "mismatched key should result in error",
+			spec:    `{"algorithm":"RS256","keySecretRef":{"name":"keys","key":"ec"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "ES256 with P-384 key should result in error",
+			spec:    `{"algorithm":"ES256","keySecretRef":{"name":"keys","key":"ec384"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "shared secret as private key should result in error",
+			spec:    `{"algorithm":"EdDSA","keySecretRef":{"name":"keys","key":"secret"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown algorithm should result in error",
+			spec:    `{"algorithm":"PS256","keySecretRef":{"name":"keys","key":"rsa"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing secret should result in error",
+			spec:    `{"algorithm":"HS256","keySecretRef":{"name":"missing","key":"secret"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "negative expiration should result in error",
+			spec:    `{"algorithm":"HS256","keySecretRef":{"name":"keys","key":"secret"},"expiration":"-1h"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid claim template should result in error",
+			spec:    `{"algorithm":"HS256","keySecretRef":{"name":"keys","key":"secret"},"claims":{"foo":"{{ .missing }}"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var jsonSpec *apiextensions.JSON
+			if tt.spec != "" {
+				jsonSpec = &apiextensions.JSON{Raw: []byte(`{"spec":` + tt.spec + `}`)}
+			}
+			got, err := (&Generator{}).generate(context.Background(), jsonSpec, kube, testNamespace, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if want := tt.wantExp.Format(time.RFC3339); string(got[keyExpiration]) != want {
+				t.Errorf("expirationTimestamp = %s, want %s", got[keyExpiration], want)
+			}
+
+			claims := gojwt.MapClaims{}
+			token, err := gojwt.ParseWithClaims(string(got[keyToken]), claims, func(*gojwt.Token) (any, error) {
+				return tt.verifyKey, nil
+			}, gojwt.WithTimeFunc(func() time.Time { return now }))
+			if err != nil {
+				t.Fatalf("unable to verify token: %v", err)
+			}
+			if kid, _ := token.Header["kid"].(string); kid != tt.wantKeyID {
+				t.Errorf("kid = %q, want %q", kid, tt.wantKeyID)
+			}
+			for k, want := range tt.wantClaims {
+				gotClaim, _ := json.Marshal(claims[k])
+				wantClaim, _ := json.Marshal(want)
+				if string(gotClaim) != string(wantClaim) {
+					t.Errorf("claim %q = %s, want %s", k, gotClaim, wantClaim)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateReferenceNamespace(t *testing.T) {
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "keys", Namespace: "shared"},
+		Data:       map[string][]byte{"secret": []byte("s3cr3t")},
+	}).Build()
+	jsonSpec := &apiextensions.JSON{Raw: []byte(`{"spec":{"algorithm":"HS256","keySecretRef":{"name":"keys","namespace":"shared","key":"secret"}}}`)}
+
+	// namespaced generators can not read secrets of other namespaces.
+	_, err := (&Generator{}).generate(context.Background(), jsonSpec, kube, testNamespace, time.Now())
+	if err == nil {
+		t.Fatalf("expected error reading a secret of another namespace")
+	}
+
+	// ClusterGenerators honour the namespace of the reference.
+	ctx := resolvers.WithGeneratorStoreKind(context.Background(), esv1beta1.ClusterSecretStoreKind)
+	got, err := (&Generator{}).generate(ctx, jsonSpec, kube, testNamespace, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got[keyToken]) == 0 {
+		t.Errorf("expected a token")
+	}
+}
+
+func pemKey(t *testing.T, key crypto.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der})
+}
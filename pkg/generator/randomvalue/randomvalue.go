@@ -0,0 +1,177 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package randomvalue
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+type Generator struct{}
+
+const (
+	defaultLength  = 32
+	maxLength      = 1024
+	defaultCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
+	placeholder    = 'x'
+	escape         = '\\'
+
+	errNoSpec        = "no config spec provided"
+	errParseSpec     = "unable to parse spec: %w"
+	errNoValues      = "no values configured"
+	errNoName        = "value %d has no name"
+	errDuplicateName = "duplicate value name %q"
+	errGenerate      = "unable to generate value %q: %w"
+	errUnknownType   = "unknown type %q"
+	errNoPattern     = "no pattern configured"
+	errTrailingEsc   = "pattern ends with an escape character"
+	errLength        = "length must not exceed %d bytes, got %d"
+)
+
+func (g *Generator) Generate(_ context.Context, jsonSpec *apiextensions.JSON, _ client.Client, _ string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	data, err := g.generate(jsonSpec)
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(jsonSpec *apiextensions.JSON) (map[string][]byte, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	if len(res.Spec.Values) == 0 {
+		return nil, errors.New(errNoValues)
+	}
+
+	out := make(map[string][]byte, len(res.Spec.Values))
+	for i, item := range res.Spec.Values {
+		if item.Name == "" {
+			return nil, fmt.Errorf(errNoName, i)
+		}
+		if _, ok := out[item.Name]; ok {
+			return nil, fmt.Errorf(errDuplicateName, item.Name)
+		}
+		val, err := generateValue(&item)
+		if err != nil {
+			return nil, fmt.Errorf(errGenerate, item.Name, err)
+		}
+		out[item.Name] = []byte(val)
+	}
+	return out, nil
+}
+
+func generateValue(item *genv1alpha1.RandomValueItem) (string, error) {
+	switch item.Type {
+	case genv1alpha1.RandomValueTypeUUIDv4:
+		id, err := uuid.NewRandom()
+		return id.String(), err
+	case genv1alpha1.RandomValueTypeUUIDv7:
+		id, err := uuid.NewV7()
+		return id.String(), err
+	case genv1alpha1.RandomValueTypeHex:
+		return randomBytes(item.Length, hex.EncodeToString)
+	case genv1alpha1.RandomValueTypeBase64:
+		return randomBytes(item.Length, base64.StdEncoding.EncodeToString)
+	case genv1alpha1.RandomValueTypeBase64URL:
+		return randomBytes(item.Length, base64.RawURLEncoding.EncodeToString)
+	case genv1alpha1.RandomValueTypeBase32:
+		return randomBytes(item.Length, base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString)
+	case genv1alpha1.RandomValueTypePattern:
+		return fromPattern(item.Pattern, item.Charset)
+	default:
+		return "", fmt.Errorf(errUnknownType, item.Type)
+	}
+}
+
+func randomBytes(length int, encode func([]byte) string) (string, error) {
+	if length <= 0 {
+		length = defaultLength
+	}
+	if length > maxLength {
+		return "", fmt.Errorf(errLength, maxLength, length)
+	}
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return encode(buf), nil
+}
+
+// fromPattern replaces every placeholder of the pattern
+// with a random character of the charset.
+func fromPattern(pattern, charset string) (string, error) {
+	if pattern == "" {
+		return "", errors.New(errNoPattern)
+	}
+	chars := []rune(charset)
+	if len(chars) == 0 {
+		chars = []rune(defaultCharset)
+	}
+	max := big.NewInt(int64(len(chars)))
+
+	var sb strings.Builder
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteRune(r)
+			escaped = false
+		case r == escape:
+			escaped = true
+		case r == placeholder:
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(chars[n.Int64()])
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if escaped {
+		return "", errors.New(errTrailingEsc)
+	}
+	return sb.String(), nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.RandomValue, error) {
+	var spec genv1alpha1.RandomValue
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.RandomValueKind, &Generator{})
+}
@@ -0,0 +1,138 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package randomvalue
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonSpec *apiextensions.JSON
+		validate map[string]func(t *testing.T, val string)
+		wantErr  bool
+	}{
+		{
+			name:    "no json spec should result in error",
+			wantErr: true,
+		},
+		{
+			name:     "invalid json spec should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`no json`)},
+			wantErr:  true,
+		},
+		{
+			name:     "spec without values should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"values":[]}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "duplicate names should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"values":[{"name":"a","type":"UUIDv4"},{"name":"a","type":"UUIDv7"}]}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown type should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"values":[{"name":"a","type":"UUIDv1"}]}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "pattern with trailing escape should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"values":[{"name":"a","type":"Pattern","pattern":"xx\\"}]}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "length above the maximum should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"values":[{"name":"a","type":"Hex","length":1025}]}}`)},
+			wantErr:  true,
+		},
+		{
+			name: "multiple values",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"values":[
+				{"name":"v4","type":"UUIDv4"},
+				{"name":"v7","type":"UUIDv7"},
+				{"name":"hex","type":"Hex","length":16},
+				{"name":"base64","type":"Base64"},
+				{"name":"base64url","type":"Base64URL","length":24},
+				{"name":"base32","type":"Base32","length":10},
+				{"name":"pattern","type":"Pattern","pattern":"sk_\\x\\xxxxx-xxxx","charset":"ABC"}]}}`)},
+			validate: map[string]func(t *testing.T, val string){
+				"v4":        uuidVersion(4),
+				"v7":        uuidVersion(7),
+				"hex":       decodedLength(hex.DecodeString, 16),
+				"base64":    decodedLength(base64.StdEncoding.DecodeString, 32),
+				"base64url": decodedLength(base64.RawURLEncoding.DecodeString, 24),
+				"base32":    decodedLength(base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString, 10),
+				"pattern":   matches(`^sk_xx[ABC]{4}-[ABC]{4}$`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			got, err := g.generate(tt.jsonSpec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Generator.Generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.validate) {
+				t.Fatalf("Generator.Generate() returned %d values, want %d", len(got), len(tt.validate))
+			}
+			for key, validate := range tt.validate {
+				validate(t, string(got[key]))
+			}
+		})
+	}
+}
+
+func uuidVersion(version uuid.Version) func(t *testing.T, val string) {
+	return func(t *testing.T, val string) {
+		id, err := uuid.Parse(val)
+		if err != nil {
+			t.Fatalf("unable to parse uuid %q: %v", val, err)
+		}
+		if id.Version() != version {
+			t.Errorf("unexpected uuid version: %d, want %d", id.Version(), version)
+		}
+	}
+}
+
+func decodedLength(decode func(string) ([]byte, error), length int) func(t *testing.T, val string) {
+	return func(t *testing.T, val string) {
+		raw, err := decode(val)
+		if err != nil {
+			t.Fatalf("unable to decode %q: %v", val, err)
+		}
+		if len(raw) != length {
+			t.Errorf("unexpected length: %d, want %d", len(raw), length)
+		}
+	}
+}
+
+func matches(pattern string) func(t *testing.T, val string) {
+	re := regexp.MustCompile(pattern)
+	return func(t *testing.T, val string) {
+		if !re.MatchString(val) {
+			t.Errorf("value %q does not match %q", val, pattern)
+		}
+	}
+}
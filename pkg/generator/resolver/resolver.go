@@ -0,0 +1,108 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resolver fetches the definitions of referenced generators.
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errClusterGenDenied = "ClusterGenerator %q can not be used from namespace %q"
+	errClusterGenSpec   = "invalid ClusterGenerator %q: %w"
+)
+
+// Definition returns the JSON of the referenced generator. Namespaced
+// generators are looked up in the given namespace.
+func Definition(ctx context.Context, kube client.Client, namespace string, ref *esv1beta1.GeneratorRef) (*apiextensions.JSON, error) {
+	if ref.Kind == genv1alpha1.ClusterGeneratorKind {
+		return ClusterGeneratorDefinition(ctx, kube, namespace, ref.Name)
+	}
+
+	apiVersion := ref.APIVersion
+	if apiVersion == "" {
+		apiVersion = genv1alpha1.SchemeGroupVersion.String()
+	}
+	var gen unstructured.Unstructured
+	gen.SetAPIVersion(apiVersion)
+	gen.SetKind(ref.Kind)
+	err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &gen)
+	if err != nil {
+		return nil, err
+	}
+	jsonRes, err := gen.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return &apiextensions.JSON{Raw: jsonRes}, nil
+}
+
+// Context returns the context the referenced generator is called with.
+// Generators wrapped by a ClusterGenerator resolve the references of their spec
+// like a ClusterSecretStore, i.e. they honour the namespace of a reference and
+// default to the given namespace otherwise. All other generators can only resolve
+// references in the given namespace.
+func Context(ctx context.Context, ref *esv1beta1.GeneratorRef) context.Context {
+	if ref.Kind == genv1alpha1.ClusterGeneratorKind {
+		return resolvers.WithGeneratorStoreKind(ctx, esv1beta1.ClusterSecretStoreKind)
+	}
+	return resolvers.WithGeneratorStoreKind(ctx, resolvers.EmptyStoreKind)
+}
+
+// ClusterGeneratorDefinition returns the JSON of the generator wrapped by a ClusterGenerator.
+// The ClusterGenerator must allow to be used from the given namespace.
+func ClusterGeneratorDefinition(ctx context.Context, kube client.Client, namespace, name string) (*apiextensions.JSON, error) {
+	var clusterGen genv1alpha1.ClusterGenerator
+	err := kube.Get(ctx, types.NamespacedName{Name: name}, &clusterGen)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := resolvers.NamespaceMatchesConditions(ctx, kube, clusterGen.Spec.Conditions, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf(errClusterGenDenied, name, namespace)
+	}
+	spec, err := clusterGen.Spec.GetSpec()
+	if err != nil {
+		return nil, fmt.Errorf(errClusterGenSpec, name, err)
+	}
+
+	gen := map[string]any{
+		"apiVersion": genv1alpha1.SchemeGroupVersion.String(),
+		"kind":       string(clusterGen.Spec.Kind),
+		"metadata": map[string]any{
+			"name": clusterGen.Name,
+		},
+		"spec": spec,
+	}
+	jsonRes, err := json.Marshal(gen)
+	if err != nil {
+		return nil, err
+	}
+	return &apiextensions.JSON{Raw: jsonRes}, nil
+}
@@ -0,0 +1,120 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+func TestDefinition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, genv1alpha1.AddToScheme(scheme))
+
+	fakeSpec := genv1alpha1.FakeSpec{Data: map[string]string{"foo": "bar"}}
+	clusterGen := func(name string, conditions ...esv1beta1.ClusterSecretStoreCondition) *genv1alpha1.ClusterGenerator {
+		return &genv1alpha1.ClusterGenerator{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: genv1alpha1.ClusterGeneratorSpec{
+				Kind:       genv1alpha1.GeneratorKindFake,
+				Generator:  genv1alpha1.GeneratorSpec{FakeSpec: &fakeSpec},
+				Conditions: conditions,
+			},
+		}
+	}
+	kube := clientfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   "labeled",
+				Labels: map[string]string{"team": "a"},
+			}},
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+			&genv1alpha1.Fake{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake", Namespace: "labeled"},
+				Spec:       fakeSpec,
+			},
+			clusterGen("any"),
+			clusterGen("by-name", esv1beta1.ClusterSecretStoreCondition{Namespaces: []string{"labeled"}}),
+			clusterGen("by-label", esv1beta1.ClusterSecretStoreCondition{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			}),
+		).
+		Build()
+
+	tests := []struct {
+		name      string
+		namespace string
+		ref       esv1beta1.GeneratorRef
+		wantErr   bool
+	}{
+		{
+			name:      "namespaced generator",
+			namespace: "labeled",
+			ref:       esv1beta1.GeneratorRef{Kind: genv1alpha1.FakeKind, Name: "fake"},
+		},
+		{
+			name:      "namespaced generator of other namespace",
+			namespace: "other",
+			ref:       esv1beta1.GeneratorRef{Kind: genv1alpha1.FakeKind, Name: "fake"},
+			wantErr:   true,
+		},
+		{
+			name:      "cluster generator without conditions",
+			namespace: "other",
+			ref:       esv1beta1.GeneratorRef{Kind: genv1alpha1.ClusterGeneratorKind, Name: "any"},
+		},
+		{
+			name:      "cluster generator allowing namespace by name",
+			namespace: "labeled",
+			ref:       esv1beta1.GeneratorRef{Kind: genv1alpha1.ClusterGeneratorKind, Name: "by-name"},
+		},
+		{
+			name:      "cluster generator allowing namespace by label",
+			namespace: "labeled",
+			ref:       esv1beta1.GeneratorRef{Kind: genv1alpha1.ClusterGeneratorKind, Name: "by-label"},
+		},
+		{
+			name:      "cluster generator denying namespace",
+			namespace: "other",
+			ref:       esv1beta1.GeneratorRef{Kind: genv1alpha1.ClusterGeneratorKind, Name: "by-label"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Definition(context.Background(), kube, tt.namespace, &tt.ref)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(got.Raw), `"kind":"Fake"`)
+			assert.Contains(t, string(got.Raw), `"foo":"bar"`)
+		})
+	}
+}
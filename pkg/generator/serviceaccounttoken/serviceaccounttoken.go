@@ -0,0 +1,129 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	provider "github.com/external-secrets/external-secrets/pkg/provider/kubernetes"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	defaultExpirationSeconds = int64(3600)
+	defaultRemoteNamespace   = "default"
+
+	errNoSpec       = "no config spec provided"
+	errParseSpec    = "unable to parse spec: %w"
+	errRemoteClient = "unable to setup client for remote cluster: %w"
+	errCreateToken  = "unable to create token for service account %q: %w"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	clientset, err := newClientset()
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := g.generate(ctx, &provider.Provider{}, jsonSpec, kube, clientset, namespace)
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+// controller-runtime/client does not support TokenRequest or other subresource APIs
+// so we need to construct our own client and use it to request tokens.
+func newClientset() (kubernetes.Interface, error) {
+	restCfg, err := ctrlcfg.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(restCfg)
+}
+
+func (g *Generator) generate(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, clientset kubernetes.Interface, namespace string) (map[string][]byte, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := res.Spec
+
+	// the token is requested from the cluster the controller runs in,
+	// unless a remote cluster is configured.
+	target, targetNamespace := clientset, namespace
+	// ClusterGenerators may request tokens of service accounts in other namespaces.
+	if resolvers.GeneratorStoreKind(ctx) == esv1beta1.ClusterSecretStoreKind && spec.ServiceAccountRef.Namespace != nil {
+		targetNamespace = *spec.ServiceAccountRef.Namespace
+	}
+	if spec.Remote != nil {
+		target, err = c.NewGeneratorClient(ctx, kube, clientset, spec.Remote, namespace)
+		if err != nil {
+			return nil, fmt.Errorf(errRemoteClient, err)
+		}
+		targetNamespace = spec.Remote.RemoteNamespace
+		if targetNamespace == "" {
+			targetNamespace = defaultRemoteNamespace
+		}
+	}
+
+	expirationSeconds := defaultExpirationSeconds
+	if spec.ExpirationSeconds != nil {
+		expirationSeconds = *spec.ExpirationSeconds
+	}
+	tr, err := target.CoreV1().ServiceAccounts(targetNamespace).CreateToken(ctx, spec.ServiceAccountRef.Name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         spec.ServiceAccountRef.Audiences,
+			ExpirationSeconds: &expirationSeconds,
+			BoundObjectRef:    spec.BoundObjectRef,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf(errCreateToken, spec.ServiceAccountRef.Name, err)
+	}
+
+	return map[string][]byte{
+		"token":               []byte(tr.Status.Token),
+		"expirationTimestamp": []byte(tr.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)),
+	}, nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.ServiceAccountToken, error) {
+	var spec genv1alpha1.ServiceAccountToken
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.ServiceAccountTokenKind, &Generator{})
+}
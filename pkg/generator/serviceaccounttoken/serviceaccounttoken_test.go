@@ -0,0 +1,129 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provider "github.com/external-secrets/external-secrets/pkg/provider/kubernetes"
+)
+
+func TestGenerate(t *testing.T) {
+	expiration := metav1.NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name        string
+		jsonSpec    *apiextensions.JSON
+		createErr   error
+		want        map[string][]byte
+		wantRequest *authenticationv1.TokenRequestSpec
+		wantErr     bool
+	}{
+		{
+			name:    "no json spec should result in error",
+			wantErr: true,
+		},
+		{
+			name:     "invalid json spec should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`no json`)},
+			wantErr:  true,
+		},
+		{
+			name:     "token with defaults",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"ci"}}}`)},
+			want: map[string][]byte{
+				"token":               []byte("token-of-ci"),
+				"expirationTimestamp": []byte("2024-01-01T12:00:00Z"),
+			},
+			wantRequest: &authenticationv1.TokenRequestSpec{
+				ExpirationSeconds: ptr.To(int64(3600)),
+			},
+		},
+		{
+			name: "token with audiences, expiration and bound object",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"ci","audiences":["ci.example.com"]},` +
+				`"expirationSeconds":600,"boundObjectRef":{"kind":"Secret","apiVersion":"v1","name":"ci-token"}}}`)},
+			want: map[string][]byte{
+				"token":               []byte("token-of-ci"),
+				"expirationTimestamp": []byte("2024-01-01T12:00:00Z"),
+			},
+			wantRequest: &authenticationv1.TokenRequestSpec{
+				Audiences:         []string{"ci.example.com"},
+				ExpirationSeconds: ptr.To(int64(600)),
+				BoundObjectRef: &authenticationv1.BoundObjectReference{
+					Kind:       "Secret",
+					APIVersion: "v1",
+					Name:       "ci-token",
+				},
+			},
+		},
+		{
+			name:      "failing token request should result in error",
+			jsonSpec:  &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"ci"}}}`)},
+			createErr: errors.New("forbidden"),
+			wantErr:   true,
+		},
+		{
+			name:     "remote cluster without credentials should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"ci"},"remote":{"server":{"url":"https://remote"},"auth":{}}}}`)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotRequest *authenticationv1.TokenRequest
+			clientset := k8sfake.NewSimpleClientset()
+			clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if tt.createErr != nil {
+					return true, nil, tt.createErr
+				}
+				create := action.(k8stesting.CreateAction)
+				assert.Equal(t, "token", create.GetSubresource())
+				assert.Equal(t, "default", create.GetNamespace())
+				gotRequest = create.GetObject().(*authenticationv1.TokenRequest)
+				return true, &authenticationv1.TokenRequest{
+					Status: authenticationv1.TokenRequestStatus{
+						Token:               "token-of-ci",
+						ExpirationTimestamp: expiration,
+					},
+				}, nil
+			})
+
+			g := &Generator{}
+			got, err := g.generate(context.Background(), &provider.Provider{}, tt.jsonSpec, clientfake.NewClientBuilder().Build(), clientset, "default")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Generator.Generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, *tt.wantRequest, gotRequest.Spec)
+		})
+	}
+}
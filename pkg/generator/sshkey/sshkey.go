@@ -0,0 +1,147 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+type Generator struct{}
+
+const (
+	defaultKeyType = genv1alpha1.SSHKeyTypeEd25519
+	defaultRSABits = 4096
+	minRSABits     = 2048
+	maxRSABits     = 8192
+	defaultECBits  = 256
+
+	errNoSpec         = "no config spec provided"
+	errParseSpec      = "unable to parse spec: %w"
+	errGenerateKey    = "unable to generate key: %w"
+	errMarshalKey     = "unable to marshal key: %w"
+	errUnknownKeyType = "unknown key type: %q"
+	errRSABits        = "rsa keys must have between %d and %d bits, got %d"
+	errECBits         = "ecdsa keys must have 256, 384 or 521 bits, got %d"
+)
+
+func (g *Generator) Generate(_ context.Context, jsonSpec *apiextensions.JSON, _ client.Client, _ string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	data, err := g.generate(jsonSpec)
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(jsonSpec *apiextensions.JSON) (map[string][]byte, error) {
+	if jsonSpec == nil {
+		return nil, fmt.Errorf(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	keyType := res.Spec.KeyType
+	if keyType == "" {
+		keyType = defaultKeyType
+	}
+	key, err := generateKey(keyType, res.Spec.Bits)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := ssh.MarshalPrivateKey(key, res.Spec.Comment)
+	if err != nil {
+		return nil, fmt.Errorf(errMarshalKey, err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, fmt.Errorf(errMarshalKey, err)
+	}
+	pub := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(signer.PublicKey())), "\n")
+	if res.Spec.Comment != "" {
+		pub += " " + res.Spec.Comment
+	}
+
+	name := "id_" + string(keyType)
+	return map[string][]byte{
+		name:          pem.EncodeToMemory(block),
+		name + ".pub": []byte(pub + "\n"),
+	}, nil
+}
+
+func generateKey(keyType genv1alpha1.SSHKeyType, bits int) (crypto.Signer, error) {
+	var (
+		key crypto.Signer
+		err error
+	)
+	switch keyType {
+	case genv1alpha1.SSHKeyTypeRSA:
+		if bits == 0 {
+			bits = defaultRSABits
+		}
+		if bits < minRSABits || bits > maxRSABits {
+			return nil, fmt.Errorf(errRSABits, minRSABits, maxRSABits, bits)
+		}
+		key, err = rsa.GenerateKey(rand.Reader, bits)
+	case genv1alpha1.SSHKeyTypeECDSA:
+		var curve elliptic.Curve
+		switch bits {
+		case 0, defaultECBits:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf(errECBits, bits)
+		}
+		key, err = ecdsa.GenerateKey(curve, rand.Reader)
+	case genv1alpha1.SSHKeyTypeEd25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf(errUnknownKeyType, keyType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errGenerateKey, err)
+	}
+	return key, nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.SSHKey, error) {
+	var spec genv1alpha1.SSHKey
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.SSHKeyKind, &Generator{})
+}
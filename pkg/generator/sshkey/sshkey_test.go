@@ -0,0 +1,135 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonSpec *apiextensions.JSON
+		wantKey  string
+		wantType string
+		wantBits int
+		comment  string
+		wantErr  bool
+	}{
+		{
+			name:    "no json spec should result in error",
+			wantErr: true,
+		},
+		{
+			name:     "invalid json spec should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`no json`)},
+			wantErr:  true,
+		},
+		{
+			name:     "empty spec should generate an ed25519 key",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{}`)},
+			wantKey:  "id_ed25519",
+			wantType: ssh.KeyAlgoED25519,
+		},
+		{
+			name:     "rsa key with custom bits",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"rsa","bits":2048,"comment":"deploy@example.com"}}`)},
+			wantKey:  "id_rsa",
+			wantType: ssh.KeyAlgoRSA,
+			wantBits: 2048,
+			comment:  "deploy@example.com",
+		},
+		{
+			name:     "ecdsa key with custom curve",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"ecdsa","bits":384}}`)},
+			wantKey:  "id_ecdsa",
+			wantType: ssh.KeyAlgoECDSA384,
+			wantBits: 384,
+		},
+		{
+			name:     "rsa key with too few bits should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"rsa","bits":1024}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "rsa key with too many bits should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"rsa","bits":16384}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "ecdsa key with unsupported curve should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"ecdsa","bits":128}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown key type should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"dsa"}}`)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			got, err := g.generate(tt.jsonSpec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Generator.Generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != 2 {
+				t.Fatalf("Generator.Generate() returned %d keys, want 2", len(got))
+			}
+
+			private, err := ssh.ParseRawPrivateKey(got[tt.wantKey])
+			if err != nil {
+				t.Fatalf("unable to parse private key: %v", err)
+			}
+			switch key := private.(type) {
+			case *rsa.PrivateKey:
+				if key.N.BitLen() != tt.wantBits {
+					t.Errorf("unexpected key size: %d, want %d", key.N.BitLen(), tt.wantBits)
+				}
+			case *ecdsa.PrivateKey:
+				if key.Curve.Params().BitSize != tt.wantBits {
+					t.Errorf("unexpected key size: %d, want %d", key.Curve.Params().BitSize, tt.wantBits)
+				}
+			}
+			signer, err := ssh.NewSignerFromKey(private)
+			if err != nil {
+				t.Fatalf("unable to create signer: %v", err)
+			}
+
+			public, comment, _, _, err := ssh.ParseAuthorizedKey(got[tt.wantKey+".pub"])
+			if err != nil {
+				t.Fatalf("unable to parse public key: %v", err)
+			}
+			if public.Type() != tt.wantType {
+				t.Errorf("unexpected key type: %s, want %s", public.Type(), tt.wantType)
+			}
+			if string(public.Marshal()) != string(signer.PublicKey().Marshal()) {
+				t.Errorf("public key does not belong to the private key")
+			}
+			if comment != tt.comment {
+				t.Errorf("unexpected comment: %q, want %q", comment, tt.comment)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	awsauth "github.com/external-secrets/external-secrets/pkg/provider/aws/auth"
+)
+
+type Generator struct{}
+
+const (
+	defaultRoleSessionName = "external-secrets"
+
+	errNoSpec     = "no config spec provided"
+	errParseSpec  = "unable to parse spec: %w"
+	errCreateSess = "unable to create aws session: %w"
+	errAssumeRole = "unable to assume role: %w"
+	errGetSession = "unable to get session token: %w"
+	errNoCreds    = "no credentials returned"
+	errTagsNoRole = "session tags and external id require a role"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	data, err := g.generate(ctx, jsonSpec, kube, namespace, stsFactory)
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	kube client.Client,
+	namespace string,
+	stsFunc stsFactoryFunc,
+) (map[string][]byte, error) {
+	if jsonSpec == nil {
+		return nil, fmt.Errorf(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := res.Spec
+	if spec.Role == "" && (spec.ExternalID != "" || len(spec.SessionTags) > 0) {
+		return nil, fmt.Errorf(errTagsNoRole)
+	}
+
+	// the role is assumed below with the request parameters of the spec,
+	// so the session only carries the source credentials.
+	sess, err := awsauth.NewGeneratorSession(
+		ctx,
+		esv1beta1.AWSAuth{
+			SecretRef: (*esv1beta1.AWSAuthSecretRef)(spec.Auth.SecretRef),
+			JWTAuth:   (*esv1beta1.AWSJWTAuth)(spec.Auth.JWTAuth),
+		},
+		"",
+		spec.Region,
+		kube,
+		namespace,
+		awsauth.DefaultSTSProvider,
+		awsauth.DefaultJWTProvider)
+	if err != nil {
+		return nil, fmt.Errorf(errCreateSess, err)
+	}
+	client := stsFunc(sess)
+
+	var creds *sts.Credentials
+	if spec.Role != "" {
+		creds, err = assumeRole(ctx, client, &spec)
+	} else {
+		creds, err = getSessionToken(ctx, client, &spec)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if creds == nil {
+		return nil, fmt.Errorf(errNoCreds)
+	}
+
+	return map[string][]byte{
+		"access_key_id":     []byte(aws.StringValue(creds.AccessKeyId)),
+		"secret_access_key": []byte(aws.StringValue(creds.SecretAccessKey)),
+		"session_token":     []byte(aws.StringValue(creds.SessionToken)),
+		"expiration":        []byte(aws.TimeValue(creds.Expiration).UTC().Format(time.RFC3339)),
+	}, nil
+}
+
+func assumeRole(ctx context.Context, client stsiface.STSAPI, spec *genv1alpha1.STSSessionTokenSpec) (*sts.Credentials, error) {
+	sessionName := spec.RoleSessionName
+	if sessionName == "" {
+		sessionName = defaultRoleSessionName
+	}
+	input := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(spec.Role),
+		RoleSessionName: aws.String(sessionName),
+		DurationSeconds: spec.DurationSeconds,
+	}
+	if spec.ExternalID != "" {
+		input.ExternalId = aws.String(spec.ExternalID)
+	}
+	for _, tag := range spec.SessionTags {
+		input.Tags = append(input.Tags, &sts.Tag{
+			Key:   aws.String(tag.Key),
+			Value: aws.String(tag.Value),
+		})
+	}
+	out, err := client.AssumeRoleWithContext(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf(errAssumeRole, err)
+	}
+	return out.Credentials, nil
+}
+
+func getSessionToken(ctx context.Context, client stsiface.STSAPI, spec *genv1alpha1.STSSessionTokenSpec) (*sts.Credentials, error) {
+	out, err := client.GetSessionTokenWithContext(ctx, &sts.GetSessionTokenInput{
+		DurationSeconds: spec.DurationSeconds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errGetSession, err)
+	}
+	return out.Credentials, nil
+}
+
+type stsFactoryFunc func(aws *session.Session) stsiface.STSAPI
+
+func stsFactory(aws *session.Session) stsiface.STSAPI {
+	return sts.New(aws)
+}
+
+func parseSpec(data []byte) (*genv1alpha1.STSSessionToken, error) {
+	var spec genv1alpha1.STSSessionToken
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.STSSessionTokenKind, &Generator{})
+}
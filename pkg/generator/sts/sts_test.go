@@ -0,0 +1,173 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	v1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const authSpec = `
+  region: eu-west-1
+  auth:
+    secretRef:
+      accessKeyIDSecretRef:
+        name: "my-aws-creds"
+        key: "key-id"
+      secretAccessKeySecretRef:
+        name: "my-aws-creds"
+        key: "access-secret"`
+
+func TestGenerate(t *testing.T) {
+	expiration := time.Unix(1234, 0)
+	creds := &sts.Credentials{
+		AccessKeyId:     aws.String("ASIA123"),
+		SecretAccessKey: aws.String("secret"),
+		SessionToken:    aws.String("token"),
+		Expiration:      &expiration,
+	}
+	want := map[string][]byte{
+		"access_key_id":     []byte("ASIA123"),
+		"secret_access_key": []byte("secret"),
+		"session_token":     []byte("token"),
+		"expiration":        []byte("1970-01-01T00:20:34Z"),
+	}
+	kube := clientfake.NewClientBuilder().WithObjects(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-aws-creds",
+			Namespace: "foobar",
+		},
+		Data: map[string][]byte{
+			"key-id":        []byte("foo"),
+			"access-secret": []byte("bar"),
+		},
+	}).Build()
+
+	tests := []struct {
+		name             string
+		jsonSpec         *apiextensions.JSON
+		assumeRoleFunc   func(*sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
+		sessionTokenFunc func(*sts.GetSessionTokenInput) (*sts.GetSessionTokenOutput, error)
+		want             map[string][]byte
+		wantErr          bool
+	}{
+		{
+			name:    "nil spec",
+			wantErr: true,
+		},
+		{
+			name:     "invalid json",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`no json`)},
+			wantErr:  true,
+		},
+		{
+			name:     "session token",
+			jsonSpec: &apiextensions.JSON{Raw: []byte("spec:" + authSpec + "\n  durationSeconds: 3600")},
+			sessionTokenFunc: func(in *sts.GetSessionTokenInput) (*sts.GetSessionTokenOutput, error) {
+				if aws.Int64Value(in.DurationSeconds) != 3600 {
+					return nil, errors.New("unexpected duration")
+				}
+				return &sts.GetSessionTokenOutput{Credentials: creds}, nil
+			},
+			want: want,
+		},
+		{
+			name: "assume role with external id and session tags",
+			jsonSpec: &apiextensions.JSON{Raw: []byte("spec:" + authSpec + `
+  role: "arn:aws:iam::123456789012:role/app"
+  externalID: "ext"
+  sessionTags:
+  - key: team
+    value: platform`)},
+			assumeRoleFunc: func(in *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+				expected := &sts.AssumeRoleInput{
+					RoleArn:         aws.String("arn:aws:iam::123456789012:role/app"),
+					RoleSessionName: aws.String(defaultRoleSessionName),
+					ExternalId:      aws.String("ext"),
+					Tags:            []*sts.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+				}
+				if !reflect.DeepEqual(in, expected) {
+					return nil, errors.New("unexpected input")
+				}
+				return &sts.AssumeRoleOutput{Credentials: creds}, nil
+			},
+			want: want,
+		},
+		{
+			name:     "session tags without role",
+			jsonSpec: &apiextensions.JSON{Raw: []byte("spec:" + authSpec + "\n  sessionTags:\n  - key: a\n    value: b")},
+			wantErr:  true,
+		},
+		{
+			name:     "failing request",
+			jsonSpec: &apiextensions.JSON{Raw: []byte("spec:" + authSpec)},
+			sessionTokenFunc: func(in *sts.GetSessionTokenInput) (*sts.GetSessionTokenOutput, error) {
+				return nil, errors.New("boom")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			got, err := g.generate(
+				context.Background(),
+				tt.jsonSpec,
+				kube,
+				"foobar",
+				func(aws *session.Session) stsiface.STSAPI {
+					return &FakeSTS{
+						assumeRoleFunc:   tt.assumeRoleFunc,
+						sessionTokenFunc: tt.sessionTokenFunc,
+					}
+				},
+			)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Generator.Generate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Generator.Generate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type FakeSTS struct {
+	stsiface.STSAPI
+	assumeRoleFunc   func(*sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
+	sessionTokenFunc func(*sts.GetSessionTokenInput) (*sts.GetSessionTokenOutput, error)
+}
+
+func (s *FakeSTS) AssumeRoleWithContext(_ aws.Context, in *sts.AssumeRoleInput, _ ...request.Option) (*sts.AssumeRoleOutput, error) {
+	return s.assumeRoleFunc(in)
+}
+
+func (s *FakeSTS) GetSessionTokenWithContext(_ aws.Context, in *sts.GetSessionTokenInput, _ ...request.Option) (*sts.GetSessionTokenOutput, error) {
+	return s.sessionTokenFunc(in)
+}
@@ -0,0 +1,385 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlscertificate
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/template/v2"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	defaultDuration  = 90 * 24 * time.Hour
+	defaultAlgorithm = genv1alpha1.TLSCertificatePrivateKeyAlgorithmECDSA
+	defaultRSASize   = 2048
+	minRSASize       = 2048
+	maxRSASize       = 8192
+	defaultECSize    = 256
+	serialNumberBits = 128
+
+	keyCertificate = "tls.crt"
+	keyPrivateKey  = "tls.key"
+	keyCA          = "ca.crt"
+	keyPKCS12      = "keystore.p12"
+
+	pemTypeCertificate = "CERTIFICATE"
+	pemTypePrivateKey  = "PRIVATE KEY"
+
+	errNoSpec          = "no config spec provided"
+	errParseSpec       = "unable to parse spec: %w"
+	errNoSubject       = "at least one of commonName, dnsNames, ipAddresses, uris or emailAddresses must be set"
+	errGenerateKey     = "unable to generate private key: %w"
+	errUnknownAlgo     = "unknown private key algorithm: %q"
+	errRSASize         = "RSA keys must have a size between %d and %d, got %d"
+	errECSize          = "ECDSA keys must have a size of 256, 384 or 521, got %d"
+	errDuration        = "duration must be positive, got %v"
+	errInvalidIP       = "invalid ip address: %q"
+	errInvalidURI      = "invalid uri %q: %w"
+	errUnknownUsage    = "unknown usage: %q"
+	errGetCA           = "unable to get CA: %w"
+	errNoCACert        = "no certificate found in CA certificate"
+	errNoCAKey         = "no private key found in CA private key"
+	errCAKeyNotSigner  = "CA private key can not be used for signing"
+	errCreateCert      = "unable to create certificate: %w"
+	errEncodePrivKey   = "unable to encode private key: %w"
+	errEncodeKeystore  = "unable to encode PKCS#12 keystore: %w"
+	errSerialNumber    = "unable to generate serial number: %w"
+	errParseCACert     = "unable to parse CA certificate: %w"
+	errCAKeyMismatched = "CA private key does not match the CA certificate"
+)
+
+var keyUsages = map[genv1alpha1.TLSCertificateKeyUsage]x509.KeyUsage{
+	genv1alpha1.TLSCertificateUsageDigitalSignature: x509.KeyUsageDigitalSignature,
+	genv1alpha1.TLSCertificateUsageKeyEncipherment:  x509.KeyUsageKeyEncipherment,
+	genv1alpha1.TLSCertificateUsageDataEncipherment: x509.KeyUsageDataEncipherment,
+	genv1alpha1.TLSCertificateUsageKeyAgreement:     x509.KeyUsageKeyAgreement,
+	genv1alpha1.TLSCertificateUsageCertSign:         x509.KeyUsageCertSign,
+	genv1alpha1.TLSCertificateUsageCRLSign:          x509.KeyUsageCRLSign,
+}
+
+var extKeyUsages = map[genv1alpha1.TLSCertificateKeyUsage]x509.ExtKeyUsage{
+	genv1alpha1.TLSCertificateUsageServerAuth:      x509.ExtKeyUsageServerAuth,
+	genv1alpha1.TLSCertificateUsageClientAuth:      x509.ExtKeyUsageClientAuth,
+	genv1alpha1.TLSCertificateUsageCodeSigning:     x509.ExtKeyUsageCodeSigning,
+	genv1alpha1.TLSCertificateUsageEmailProtection: x509.ExtKeyUsageEmailProtection,
+}
+
+// issuer signs certificates.
+type issuer struct {
+	cert    *x509.Certificate
+	key     crypto.Signer
+	certPEM []byte
+}
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	data, err := g.generate(ctx, jsonSpec, kube, namespace, time.Now())
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, now time.Time) (map[string][]byte, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := res.Spec
+
+	tpl, err := certificateTemplate(&spec, now)
+	if err != nil {
+		return nil, err
+	}
+	key, err := generateKey(spec.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	if _, isRSA := key.(*rsa.PrivateKey); isRSA && len(spec.Usages) == 0 {
+		tpl.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	var ca *issuer
+	if spec.CA != nil {
+		ca, err = getIssuer(ctx, spec.CA, kube, namespace)
+		if err != nil {
+			return nil, fmt.Errorf(errGetCA, err)
+		}
+	}
+
+	parent, signer := tpl, key
+	if ca != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, parent, key.Public(), signer)
+	if err != nil {
+		return nil, fmt.Errorf(errCreateCert, err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: der})
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf(errEncodePrivKey, err)
+	}
+
+	out := map[string][]byte{
+		keyCertificate: certPEM,
+		keyPrivateKey:  pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: keyDER}),
+		keyCA:          certPEM,
+	}
+	var caCerts []*x509.Certificate
+	if ca != nil {
+		out[keyCA] = ca.certPEM
+		caCerts = []*x509.Certificate{ca.cert}
+	}
+
+	if spec.PKCS12 {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf(errCreateCert, err)
+		}
+		pfx, err := template.EncodePKCS12(key, cert, caCerts, "")
+		if err != nil {
+			return nil, fmt.Errorf(errEncodeKeystore, err)
+		}
+		out[keyPKCS12] = pfx
+	}
+
+	return out, nil
+}
+
+func certificateTemplate(spec *genv1alpha1.TLSCertificateSpec, now time.Time) (*x509.Certificate, error) {
+	if spec.CommonName == "" && len(spec.DNSNames) == 0 && len(spec.IPAddresses) == 0 &&
+		len(spec.URIs) == 0 && len(spec.EmailAddresses) == 0 {
+		return nil, errors.New(errNoSubject)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, fmt.Errorf(errSerialNumber, err)
+	}
+	duration := defaultDuration
+	if spec.Duration != nil {
+		duration = spec.Duration.Duration
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf(errDuration, duration)
+	}
+
+	tpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   spec.CommonName,
+			Organization: spec.Organizations,
+		},
+		DNSNames:              spec.DNSNames,
+		EmailAddresses:        spec.EmailAddresses,
+		NotBefore:             now,
+		NotAfter:              now.Add(duration),
+		IsCA:                  spec.IsCA,
+		BasicConstraintsValid: true,
+	}
+	for _, ip := range spec.IPAddresses {
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return nil, fmt.Errorf(errInvalidIP, ip)
+		}
+		tpl.IPAddresses = append(tpl.IPAddresses, parsed)
+	}
+	for _, uri := range spec.URIs {
+		parsed, err := url.Parse(uri)
+		if err != nil {
+			return nil, fmt.Errorf(errInvalidURI, uri, err)
+		}
+		tpl.URIs = append(tpl.URIs, parsed)
+	}
+
+	usages := spec.Usages
+	if len(usages) == 0 {
+		usages = []genv1alpha1.TLSCertificateKeyUsage{
+			genv1alpha1.TLSCertificateUsageDigitalSignature,
+			genv1alpha1.TLSCertificateUsageServerAuth,
+		}
+	}
+	for _, usage := range usages {
+		if u, ok := keyUsages[usage]; ok {
+			tpl.KeyUsage |= u
+		} else if u, ok := extKeyUsages[usage]; ok {
+			tpl.ExtKeyUsage = append(tpl.ExtKeyUsage, u)
+		} else {
+			return nil, fmt.Errorf(errUnknownUsage, usage)
+		}
+	}
+	if spec.IsCA {
+		tpl.KeyUsage |= x509.KeyUsageCertSign
+	}
+
+	return tpl, nil
+}
+
+func generateKey(spec genv1alpha1.TLSCertificatePrivateKey) (crypto.Signer, error) {
+	algorithm := spec.Algorithm
+	if algorithm == "" {
+		algorithm = defaultAlgorithm
+	}
+
+	var (
+		key crypto.Signer
+		err error
+	)
+	switch algorithm {
+	case genv1alpha1.TLSCertificatePrivateKeyAlgorithmRSA:
+		size := spec.Size
+		if size == 0 {
+			size = defaultRSASize
+		}
+		if size < minRSASize || size > maxRSASize {
+			return nil, fmt.Errorf(errRSASize, minRSASize, maxRSASize, size)
+		}
+		key, err = rsa.GenerateKey(rand.Reader, size)
+	case genv1alpha1.TLSCertificatePrivateKeyAlgorithmECDSA:
+		var curve elliptic.Curve
+		switch spec.Size {
+		case 0, defaultECSize:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf(errECSize, spec.Size)
+		}
+		key, err = ecdsa.GenerateKey(curve, rand.Reader)
+	case genv1alpha1.TLSCertificatePrivateKeyAlgorithmEd25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf(errUnknownAlgo, algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errGenerateKey, err)
+	}
+	return key, nil
+}
+
+// getIssuer reads the CA certificate and private key from the referenced secrets.
+func getIssuer(ctx context.Context, ref *genv1alpha1.TLSCertificateCA, kube client.Client, namespace string) (*issuer, error) {
+	certData, err := resolvers.SecretKeyRef(ctx, kube, resolvers.GeneratorStoreKind(ctx), namespace, &ref.CertSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	keyData, err := resolvers.SecretKeyRef(ctx, kube, resolvers.GeneratorStoreKind(ctx), namespace, &ref.KeySecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	certPEM, err := template.FilterPEM(pemTypeCertificate, certData)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return nil, errors.New(errNoCACert)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf(errParseCACert, err)
+	}
+
+	key, err := parseSigner(keyData)
+	if err != nil {
+		return nil, err
+	}
+	if !publicKeysEqual(cert.PublicKey, key.Public()) {
+		return nil, errors.New(errCAKeyMismatched)
+	}
+
+	return &issuer{
+		cert:    cert,
+		key:     key,
+		certPEM: []byte(certPEM),
+	}, nil
+}
+
+// parseSigner returns the first private key found in the PEM encoded data.
+func parseSigner(data string) (crypto.Signer, error) {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New(errNoCAKey)
+		}
+		if !strings.HasSuffix(block.Type, pemTypePrivateKey) {
+			continue
+		}
+		key, err := template.ParsePrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New(errCAKeyNotSigner)
+		}
+		return signer, nil
+	}
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	aDER, err := x509.MarshalPKIXPublicKey(a)
+	if err != nil {
+		return false
+	}
+	bDER, err := x509.MarshalPKIXPublicKey(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aDER, bDER)
+}
+
+func parseSpec(data []byte) (*genv1alpha1.TLSCertificate, error) {
+	var spec genv1alpha1.TLSCertificate
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.TLSCertificateKind, &Generator{})
+}
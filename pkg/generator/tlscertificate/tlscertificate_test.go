@@ -0,0 +1,257 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlscertificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gopkcs12 "software.sslmate.com/src/go-pkcs12"
+)
+
+const testNamespace = "default"
+
+func TestGenerate(t *testing.T) {
+	ca, err := (&Generator{}).generate(context.Background(), &apiextensions.JSON{
+		Raw: []byte(`{"spec":{"commonName":"test-ca","isCA":true}}`),
+	}, nil, testNamespace, time.Now())
+	if err != nil {
+		t.Fatalf("unable to generate CA: %v", err)
+	}
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: testNamespace},
+		Data: map[string][]byte{
+			"tls.crt": ca[keyCertificate],
+			"tls.key": ca[keyPrivateKey],
+		},
+	}
+	otherCA, err := (&Generator{}).generate(context.Background(), &apiextensions.JSON{
+		Raw: []byte(`{"spec":{"commonName":"other-ca","isCA":true}}`),
+	}, nil, testNamespace, time.Now())
+	if err != nil {
+		t.Fatalf("unable to generate CA: %v", err)
+	}
+	mismatchedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mismatched", Namespace: testNamespace},
+		Data: map[string][]byte{
+			"tls.crt": ca[keyCertificate],
+			"tls.key": otherCA[keyPrivateKey],
+		},
+	}
+	kube := clientfake.NewClientBuilder().WithObjects(caSecret, mismatchedSecret).Build()
+	caSpec := `"ca":{"certSecretRef":{"name":"ca","key":"tls.crt"},"keySecretRef":{"name":"ca","key":"tls.key"}}`
+
+	tests := []struct {
+		name       string
+		jsonSpec   *apiextensions.JSON
+		kube       client.Client
+		wantCA     []byte
+		wantKey    interface{}
+		wantDNS    []string
+		wantUsage  x509.KeyUsage
+		wantPKCS12 bool
+		wantErr    bool
+	}{
+		{
+			name:    "no json spec should result in error",
+			wantErr: true,
+		},
+		{
+			name:     "invalid json spec should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`no json`)},
+			wantErr:  true,
+		},
+		{
+			name:     "spec without subject should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{}}`)},
+			wantErr:  true,
+		},
+		{
+			name:      "self-signed certificate with default key",
+			jsonSpec:  &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"example.com","dnsNames":["example.com"]}}`)},
+			wantKey:   &ecdsa.PrivateKey{},
+			wantDNS:   []string{"example.com"},
+			wantUsage: x509.KeyUsageDigitalSignature,
+		},
+		{
+			name:       "self-signed rsa certificate with keystore",
+			jsonSpec:   &apiextensions.JSON{Raw: []byte(`{"spec":{"dnsNames":["example.com"],"privateKey":{"algorithm":"RSA"},"pkcs12":true}}`)},
+			wantKey:    &rsa.PrivateKey{},
+			wantDNS:    []string{"example.com"},
+			wantUsage:  x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			wantPKCS12: true,
+		},
+		{
+			name:      "certificate signed by a CA",
+			jsonSpec:  &apiextensions.JSON{Raw: []byte(`{"spec":{"dnsNames":["foo.example.com"],"privateKey":{"algorithm":"Ed25519"},"usages":["digital signature","server auth"],` + caSpec + `}}`)},
+			kube:      kube,
+			wantCA:    ca[keyCertificate],
+			wantKey:   ed25519.PrivateKey{},
+			wantDNS:   []string{"foo.example.com"},
+			wantUsage: x509.KeyUsageDigitalSignature,
+		},
+		{
+			name:       "certificate signed by a CA with keystore",
+			jsonSpec:   &apiextensions.JSON{Raw: []byte(`{"spec":{"dnsNames":["foo.example.com"],"pkcs12":true,` + caSpec + `}}`)},
+			kube:       kube,
+			wantCA:     ca[keyCertificate],
+			wantKey:    &ecdsa.PrivateKey{},
+			wantDNS:    []string{"foo.example.com"},
+			wantUsage:  x509.KeyUsageDigitalSignature,
+			wantPKCS12: true,
+		},
+		{
+			name:     "missing CA secret should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"foo","ca":{"certSecretRef":{"name":"missing","key":"tls.crt"},"keySecretRef":{"name":"missing","key":"tls.key"}}}}`)},
+			kube:     kube,
+			wantErr:  true,
+		},
+		{
+			name:     "CA key not matching the CA certificate should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"foo","ca":{"certSecretRef":{"name":"mismatched","key":"tls.crt"},"keySecretRef":{"name":"mismatched","key":"tls.key"}}}}`)},
+			kube:     kube,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid ip address should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"ipAddresses":["not-an-ip"]}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "rsa key with too small size should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"foo","privateKey":{"algorithm":"RSA","size":1024}}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "rsa key with too large size should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"foo","privateKey":{"algorithm":"RSA","size":16384}}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "zero duration should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"foo","duration":"0s"}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "negative duration should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"foo","duration":"-1h"}}`)},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown usage should result in error",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"commonName":"foo","usages":["unknown"]}}`)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			got, err := g.generate(context.Background(), tt.jsonSpec, tt.kube, testNamespace, time.Now())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Generator.Generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			pair, err := tls.X509KeyPair(got[keyCertificate], got[keyPrivateKey])
+			if err != nil {
+				t.Fatalf("certificate and private key do not match: %v", err)
+			}
+			switch tt.wantKey.(type) {
+			case *ecdsa.PrivateKey:
+				_, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+				assertTrue(t, ok, "expected an ECDSA key")
+			case *rsa.PrivateKey:
+				_, ok := pair.PrivateKey.(*rsa.PrivateKey)
+				assertTrue(t, ok, "expected a RSA key")
+			case ed25519.PrivateKey:
+				_, ok := pair.PrivateKey.(ed25519.PrivateKey)
+				assertTrue(t, ok, "expected an Ed25519 key")
+			}
+
+			cert := parseCertificate(t, got[keyCertificate])
+			if cert.KeyUsage != tt.wantUsage {
+				t.Errorf("unexpected key usage: %v, want %v", cert.KeyUsage, tt.wantUsage)
+			}
+			if len(cert.DNSNames) != len(tt.wantDNS) || cert.DNSNames[0] != tt.wantDNS[0] {
+				t.Errorf("unexpected dns names: %v, want %v", cert.DNSNames, tt.wantDNS)
+			}
+
+			wantCA := tt.wantCA
+			if wantCA == nil {
+				wantCA = got[keyCertificate]
+			}
+			if string(got[keyCA]) != string(wantCA) {
+				t.Errorf("unexpected CA certificate")
+			}
+			roots := x509.NewCertPool()
+			roots.AddCert(parseCertificate(t, got[keyCA]))
+			_, err = cert.Verify(x509.VerifyOptions{
+				DNSName: tt.wantDNS[0],
+				Roots:   roots,
+			})
+			if err != nil {
+				t.Errorf("unable to verify certificate: %v", err)
+			}
+
+			_, ok := got[keyPKCS12]
+			if ok != tt.wantPKCS12 {
+				t.Fatalf("unexpected keystore presence: %v, want %v", ok, tt.wantPKCS12)
+			}
+			if tt.wantPKCS12 {
+				_, p12Cert, _, err := gopkcs12.DecodeChain(got[keyPKCS12], "")
+				if err != nil {
+					t.Fatalf("unable to decode keystore: %v", err)
+				}
+				if !p12Cert.Equal(cert) {
+					t.Errorf("keystore does not contain the certificate")
+				}
+			}
+		})
+	}
+}
+
+func parseCertificate(t *testing.T, data []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("no PEM data found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("unable to parse certificate: %v", err)
+	}
+	return cert
+}
+
+func assertTrue(t *testing.T, ok bool, msg string) {
+	t.Helper()
+	if !ok {
+		t.Error(msg)
+	}
+}
@@ -29,24 +29,61 @@ import (
 
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	provider "github.com/external-secrets/external-secrets/pkg/provider/vault"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault/util"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
 type Generator struct{}
 
+// state identifies the lease of a generated dynamic secret,
+// so it can be revoked once the secret is no longer used.
+type state struct {
+	// LeaseID of a dynamic secret.
+	LeaseID string `json:"leaseID,omitempty"`
+	// Accessor of a token returned as auth result.
+	Accessor string `json:"accessor,omitempty"`
+}
+
 const (
 	errNoSpec      = "no config spec provided"
 	errParseSpec   = "unable to parse spec: %w"
+	errParseState  = "unable to parse state: %w"
 	errVaultClient = "unable to setup Vault client: %w"
 	errGetSecret   = "unable to get dynamic secret: %w"
+	errRevokeLease = "unable to revoke lease: %w"
+
+	pathRevokeLease    = "sys/leases/revoke"
+	pathRevokeAccessor = "auth/token/revoke-accessor"
 )
 
-func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, error) {
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	c := &provider.Provider{NewVaultClient: provider.NewVaultClient}
+	corev1, err := newCoreV1()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return g.generate(ctx, c, jsonSpec, kube, corev1, namespace)
+}
+
+// Cleanup revokes the lease of a previously generated dynamic secret.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previous genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	if previous == nil {
+		return nil
+	}
 	c := &provider.Provider{NewVaultClient: provider.NewVaultClient}
+	corev1, err := newCoreV1()
+	if err != nil {
+		return err
+	}
+
+	return g.cleanup(ctx, c, jsonSpec, previous, kube, corev1, namespace)
+}
 
-	// controller-runtime/client does not support TokenRequest or other subresource APIs
-	// so we need to construct our own client and use it to fetch tokens
-	// (for Kubernetes service account token auth)
+// controller-runtime/client does not support TokenRequest or other subresource APIs
+// so we need to construct our own client and use it to fetch tokens
+// (for Kubernetes service account token auth).
+func newCoreV1() (typedcorev1.CoreV1Interface, error) {
 	restCfg, err := ctrlcfg.GetConfig()
 	if err != nil {
 		return nil, err
@@ -56,23 +93,57 @@ func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON,
 		return nil, err
 	}
 
-	return g.generate(ctx, c, jsonSpec, kube, clientset.CoreV1(), namespace)
+	return clientset.CoreV1(), nil
 }
 
-func (g *Generator) generate(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (map[string][]byte, error) {
+func (g *Generator) newClient(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (*genv1alpha1.VaultDynamicSecret, util.Client, error) {
 	if jsonSpec == nil {
-		return nil, fmt.Errorf(errNoSpec)
+		return nil, nil, fmt.Errorf(errNoSpec)
 	}
 	res, err := parseSpec(jsonSpec.Raw)
 	if err != nil {
-		return nil, fmt.Errorf(errParseSpec, err)
+		return nil, nil, fmt.Errorf(errParseSpec, err)
 	}
 	if res == nil || res.Spec.Provider == nil {
-		return nil, fmt.Errorf("no Vault provider config in spec")
+		return nil, nil, fmt.Errorf("no Vault provider config in spec")
 	}
 	cl, err := c.NewGeneratorClient(ctx, kube, corev1, res.Spec.Provider, namespace)
 	if err != nil {
-		return nil, fmt.Errorf(errVaultClient, err)
+		return nil, nil, fmt.Errorf(errVaultClient, err)
+	}
+
+	return res, cl, nil
+}
+
+func (g *Generator) cleanup(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, previous genv1alpha1.GeneratorProviderState, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) error {
+	var st state
+	if err := json.Unmarshal(previous.Raw, &st); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	if st.LeaseID == "" && st.Accessor == "" {
+		return nil
+	}
+	_, cl, err := g.newClient(ctx, c, jsonSpec, kube, corev1, namespace)
+	if err != nil {
+		return err
+	}
+
+	if st.LeaseID != "" {
+		_, err = cl.Logical().WriteWithContext(ctx, pathRevokeLease, map[string]interface{}{"lease_id": st.LeaseID})
+	} else {
+		_, err = cl.Logical().WriteWithContext(ctx, pathRevokeAccessor, map[string]interface{}{"accessor": st.Accessor})
+	}
+	if err != nil {
+		return fmt.Errorf(errRevokeLease, err)
+	}
+
+	return nil
+}
+
+func (g *Generator) generate(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	res, cl, err := g.newClient(ctx, c, jsonSpec, kube, corev1, namespace)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var result *vault.Secret
@@ -87,40 +158,52 @@ func (g *Generator) generate(ctx context.Context, c *provider.Provider, jsonSpec
 		if res.Spec.Parameters != nil {
 			err = json.Unmarshal(res.Spec.Parameters.Raw, &params)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 		result, err = cl.Logical().WriteWithContext(ctx, res.Spec.Path, params)
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if result == nil {
-		return nil, fmt.Errorf(errGetSecret, fmt.Errorf("empty response from Vault"))
+		return nil, nil, fmt.Errorf(errGetSecret, fmt.Errorf("empty response from Vault"))
 	}
 
+	var st state
 	data := make(map[string]interface{})
 	response := make(map[string][]byte)
 	if res.Spec.ResultType == genv1alpha1.VaultDynamicSecretResultTypeAuth {
+		if result.Auth != nil {
+			st.Accessor = result.Auth.Accessor
+		}
 		authJSON, err := json.Marshal(result.Auth)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		err = json.Unmarshal(authJSON, &data)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
+		st.LeaseID = result.LeaseID
 		data = result.Data
 	}
 
 	for k := range data {
 		response[k], err = utils.GetByteValueFromMap(data, k)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return response, nil
+	if st.LeaseID == "" && st.Accessor == "" {
+		return response, nil, nil
+	}
+	raw, err := json.Marshal(st)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response, &apiextensions.JSON{Raw: raw}, nil
 }
 
 func parseSpec(data []byte) (*genv1alpha1.VaultDynamicSecret, error) {
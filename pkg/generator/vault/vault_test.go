@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	vault "github.com/hashicorp/vault/api"
 	corev1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,6 +32,7 @@ import (
 	utilfake "github.com/external-secrets/external-secrets/pkg/provider/util/fake"
 	provider "github.com/external-secrets/external-secrets/pkg/provider/vault"
 	"github.com/external-secrets/external-secrets/pkg/provider/vault/fake"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault/util"
 )
 
 type args struct {
@@ -168,7 +170,7 @@ spec:
 		t.Run(name, func(t *testing.T) {
 			c := &provider.Provider{NewVaultClient: fake.ClientWithLoginMock}
 			gen := &Generator{}
-			val, err := gen.generate(context.Background(), c, tc.args.jsonSpec, tc.args.kube, tc.args.corev1, "testing")
+			val, _, err := gen.generate(context.Background(), c, tc.args.jsonSpec, tc.args.kube, tc.args.corev1, "testing")
 			if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
 				t.Errorf("\n%s\nvault.GetSecret(...): -want error, +got error:\n%s", tc.reason, diff)
 			}
@@ -178,3 +180,72 @@ spec:
 		})
 	}
 }
+
+func TestVaultDynamicSecretGeneratorLease(t *testing.T) {
+	const leaseID = "database/creds/readonly/abc"
+	jsonSpec := &apiextensions.JSON{
+		Raw: []byte(`apiVersion: generators.external-secrets.io/v1alpha1
+kind: VaultDynamicSecret
+spec:
+  provider:
+    auth:
+      kubernetes:
+        role: test
+        serviceAccountRef:
+          name: "testing"
+  method: GET
+  path: "database/creds/readonly"`),
+	}
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testing",
+			Namespace: "testing",
+		},
+	}).Build()
+
+	var revoked map[string]interface{}
+	logical := fake.Logical{
+		ReadWithDataWithContextFn: func(_ context.Context, _ string, _ map[string][]string) (*vault.Secret, error) {
+			return &vault.Secret{
+				LeaseID: leaseID,
+				Data:    map[string]interface{}{"username": "foo"},
+			}, nil
+		},
+		WriteWithContextFn: func(_ context.Context, path string, data map[string]interface{}) (*vault.Secret, error) {
+			if path != "sys/leases/revoke" {
+				return nil, fmt.Errorf("unexpected path %q", path)
+			}
+			revoked = data
+			return nil, nil
+		},
+	}
+	c := &provider.Provider{NewVaultClient: func(cfg *vault.Config) (util.Client, error) {
+		cl, err := fake.ClientWithLoginMock(cfg)
+		if err != nil {
+			return nil, err
+		}
+		vc := cl.(*util.VaultClient)
+		vc.LogicalField = logical
+		return vc, nil
+	}}
+	corev1 := utilfake.NewCreateTokenMock().WithToken("ok")
+
+	gen := &Generator{}
+	val, state, err := gen.generate(context.Background(), c, jsonSpec, kube, corev1, "testing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string][]byte{"username": []byte("foo")}, val); diff != "" {
+		t.Errorf("unexpected value: -want, +got:\n%s", diff)
+	}
+	if state == nil || string(state.Raw) != `{"leaseID":"`+leaseID+`"}` {
+		t.Fatalf("unexpected state: %v", state)
+	}
+
+	if err := gen.cleanup(context.Background(), c, jsonSpec, state, kube, corev1, "testing"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"lease_id": leaseID}, revoked); diff != "" {
+		t.Errorf("unexpected revocation: -want, +got:\n%s", diff)
+	}
+}
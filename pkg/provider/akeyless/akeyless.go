@@ -15,6 +15,7 @@ limitations under the License.
 package akeyless
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -44,8 +45,8 @@ import (
 )
 
 const (
-	defaultAPIUrl     = "https://api.akeyless.io"
-	errNotImplemented = "not implemented"
+	defaultAPIUrl    = "https://api.akeyless.io"
+	staticSecretType = "STATIC_SECRET"
 )
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -65,6 +66,10 @@ type akeylessBase struct {
 
 	akeylessGwAPIURL string
 	RestAPI          *akeyless.V2ApiService
+
+	dynamicSecretFormat esv1beta1.AkeylessDynamicSecretFormat
+	// expiration is the earliest expiration of the dynamic secrets read by the client.
+	expiration time.Time
 }
 
 type Akeyless struct {
@@ -82,6 +87,20 @@ type akeylessVaultInterface interface {
 	GetSecretByType(ctx context.Context, secretName, token string, version int32) (string, error)
 	TokenFromSecretRef(ctx context.Context) (string, error)
 	ListSecrets(ctx context.Context, path, tag, token string) ([]string, error)
+	// DescribeItem returns nil without error if the item does not exist.
+	DescribeItem(ctx context.Context, itemName, token string) (*akeyless.Item, error)
+	CreateSecret(ctx context.Context, remoteKey, data string, tags []string, protectionKey, token string) error
+	UpdateSecret(ctx context.Context, remoteKey, data string, tags []string, token string) error
+	DeleteSecret(ctx context.Context, remoteKey, token string) error
+	// Expiration returns the earliest time a dynamic secret read by the client expires.
+	Expiration() time.Time
+}
+
+// PushSecretMetadata is the metadata accepted by PushSecret for Akeyless.
+// Tags are added to the static secret, the protection key is only used when the secret is created.
+type PushSecretMetadata struct {
+	Tags          []string `json:"tags,omitempty"`
+	ProtectionKey string   `json:"protectionKey,omitempty"`
 }
 
 func init() {
@@ -92,7 +111,7 @@ func init() {
 
 // Capabilities return the provider supported capabilities (ReadOnly, WriteOnly, ReadWrite).
 func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
-	return esv1beta1.SecretStoreReadOnly
+	return esv1beta1.SecretStoreReadWrite
 }
 
 // NewClient constructs a new secrets client based on the provided store.
@@ -218,6 +237,7 @@ func newClient(_ context.Context, store esv1beta1.GenericStore, kube client.Clie
 
 	akl.akeylessGwAPIURL = akeylessGwAPIURL
 	akl.RestAPI = RestAPIClient
+	akl.dynamicSecretFormat = spec.DynamicSecretFormat
 	return &Akeyless{Client: akl, url: akeylessGwAPIURL}, nil
 }
 
@@ -225,6 +245,15 @@ func (a *Akeyless) Close(_ context.Context) error {
 	return nil
 }
 
+// Implements esv1beta1.SecretsExpirationClient.
+// Returns the earliest time a dynamic secret read by the client expires.
+func (a *Akeyless) Expiration() time.Time {
+	if utils.IsNil(a.Client) {
+		return time.Time{}
+	}
+	return a.Client.Expiration()
+}
+
 func (a *Akeyless) Validate() (esv1beta1.ValidationResult, error) {
 	timeout := 15 * time.Second
 	url := a.url
@@ -236,16 +265,179 @@ func (a *Akeyless) Validate() (esv1beta1.ValidationResult, error) {
 	return esv1beta1.ValidationResultReady, nil
 }
 
-func (a *Akeyless) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
-	return fmt.Errorf(errNotImplemented)
+// Implements store.Client.PushSecret Interface.
+// Creates or updates the static secret defined in data.RemoteKey.
+// If a property is given, the static secret is handled as a JSON object and only the property is set.
+func (a *Akeyless) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if utils.IsNil(a.Client) {
+		return fmt.Errorf(errUninitalizedAkeylessProvider)
+	}
+	metadata, err := parsePushSecretMetadata(data)
+	if err != nil {
+		return err
+	}
+
+	token, err := a.Client.TokenFromSecretRef(ctx)
+	if err != nil {
+		return err
+	}
+	exists, current, err := a.getStaticSecret(ctx, data.GetRemoteKey(), token)
+	if err != nil {
+		return err
+	}
+
+	value, err := buildPushValue(current, secret, data)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return a.Client.CreateSecret(ctx, data.GetRemoteKey(), value, metadata.Tags, metadata.ProtectionKey, token)
+	}
+	if value == current {
+		return nil
+	}
+	return a.Client.UpdateSecret(ctx, data.GetRemoteKey(), value, metadata.Tags, token)
+}
+
+// Implements store.Client.DeleteSecret Interface.
+// Deletes the static secret or, if a property is given, removes the property from its JSON value.
+func (a *Akeyless) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	if utils.IsNil(a.Client) {
+		return fmt.Errorf(errUninitalizedAkeylessProvider)
+	}
+
+	token, err := a.Client.TokenFromSecretRef(ctx)
+	if err != nil {
+		return err
+	}
+	exists, current, err := a.getStaticSecret(ctx, remoteRef.GetRemoteKey(), token)
+	if err != nil || !exists {
+		return err
+	}
+
+	if remoteRef.GetProperty() != "" {
+		kv, err := unmarshalPushedValue(current)
+		if err != nil {
+			return err
+		}
+		if _, ok := kv[remoteRef.GetProperty()]; !ok {
+			return nil
+		}
+		delete(kv, remoteRef.GetProperty())
+		if len(kv) > 0 {
+			out, err := json.Marshal(kv)
+			if err != nil {
+				return fmt.Errorf(errJSONSecretMarshal, err)
+			}
+			return a.Client.UpdateSecret(ctx, remoteRef.GetRemoteKey(), string(out), nil, token)
+		}
+	}
+	return a.Client.DeleteSecret(ctx, remoteRef.GetRemoteKey(), token)
+}
+
+// Implements store.Client.SecretExists Interface.
+func (a *Akeyless) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	if utils.IsNil(a.Client) {
+		return false, fmt.Errorf(errUninitalizedAkeylessProvider)
+	}
+
+	token, err := a.Client.TokenFromSecretRef(ctx)
+	if err != nil {
+		return false, err
+	}
+	exists, current, err := a.getStaticSecret(ctx, remoteRef.GetRemoteKey(), token)
+	if err != nil || !exists || remoteRef.GetProperty() == "" {
+		return exists, err
+	}
+
+	kv, err := unmarshalPushedValue(current)
+	if err != nil {
+		return false, err
+	}
+	_, ok := kv[remoteRef.GetProperty()]
+	return ok, nil
 }
 
-func (a *Akeyless) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
-	return fmt.Errorf(errNotImplemented)
+// getStaticSecret returns the value of the static secret and whether it exists.
+// An error is returned if an item of another type exists with the same name.
+func (a *Akeyless) getStaticSecret(ctx context.Context, name, token string) (bool, string, error) {
+	item, err := a.Client.DescribeItem(ctx, name, token)
+	if err != nil {
+		return false, "", err
+	}
+	if item == nil || item.ItemType == nil {
+		return false, "", nil
+	}
+	if item.GetItemType() != staticSecretType {
+		return false, "", fmt.Errorf(errNotStaticSecret, name, item.GetItemType())
+	}
+
+	value, err := a.Client.GetSecretByType(ctx, name, token, 0)
+	if err != nil {
+		return false, "", err
+	}
+	return true, value, nil
 }
 
-func (a *Akeyless) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
-	return false, fmt.Errorf(errNotImplemented)
+func parsePushSecretMetadata(data esv1beta1.PushSecretData) (*PushSecretMetadata, error) {
+	metadata := &PushSecretMetadata{}
+	if data.GetMetadata() == nil {
+		return metadata, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data.GetMetadata().Raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode PushSecret metadata: %w", err)
+	}
+	return metadata, nil
+}
+
+func buildPushValue(current string, secret *corev1.Secret, data esv1beta1.PushSecretData) (string, error) {
+	if data.GetSecretKey() == "" {
+		if data.GetProperty() != "" {
+			return "", fmt.Errorf("cannot push the whole secret into property %q", data.GetProperty())
+		}
+		kv := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			kv[k] = string(v)
+		}
+		out, err := json.Marshal(kv)
+		if err != nil {
+			return "", fmt.Errorf(errJSONSecretMarshal, err)
+		}
+		return string(out), nil
+	}
+
+	value, ok := secret.Data[data.GetSecretKey()]
+	if !ok {
+		return "", fmt.Errorf(errSecretKeyFmt, data.GetSecretKey())
+	}
+	if data.GetProperty() == "" {
+		return string(value), nil
+	}
+
+	kv, err := unmarshalPushedValue(current)
+	if err != nil {
+		return "", err
+	}
+	kv[data.GetProperty()] = string(value)
+	out, err := json.Marshal(kv)
+	if err != nil {
+		return "", fmt.Errorf(errJSONSecretMarshal, err)
+	}
+	return string(out), nil
+}
+
+func unmarshalPushedValue(value string) (map[string]interface{}, error) {
+	kv := make(map[string]interface{})
+	if value == "" {
+		return kv, nil
+	}
+	if err := json.Unmarshal([]byte(value), &kv); err != nil {
+		return nil, fmt.Errorf(errJSONSecretUnmarshal, err)
+	}
+	return kv, nil
 }
 
 // Implements store.Client.GetSecret Interface.
@@ -21,8 +21,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	aws_cloud_id "github.com/akeylesslabs/akeyless-go-cloud-id/cloudprovider/aws"
 	azure_cloud_id "github.com/akeylesslabs/akeyless-go-cloud-id/cloudprovider/azure"
@@ -42,7 +45,10 @@ import (
 
 var apiErr akeyless.GenericOpenAPIError
 
-const DefServiceAccountFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+const (
+	DefServiceAccountFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	dynamicSecretTTLKey   = "ttl_in_minutes"
+)
 
 func (a *akeylessBase) GetToken(accessID, accType, accTypeParam string, k8sAuth *esv1beta1.AkeylessKubernetesAuth) (string, error) {
 	ctx := context.Background()
@@ -91,7 +97,7 @@ func (a *akeylessBase) GetSecretByType(ctx context.Context, secretName, token st
 	secretType := item.GetItemType()
 
 	switch secretType {
-	case "STATIC_SECRET":
+	case staticSecretType:
 		return a.GetStaticSecret(ctx, secretName, token, version)
 	case "DYNAMIC_SECRET":
 		return a.GetDynamicSecrets(ctx, secretName, token)
@@ -116,15 +122,15 @@ func (a *akeylessBase) DescribeItem(ctx context.Context, itemName, token string)
 	gsvOut, res, err := a.RestAPI.DescribeItem(ctx).Body(body).Execute()
 	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDescribeItem, err)
 	if err != nil {
+		// only a missing item is reported without error,
+		// auth and permission errors must not look like a missing item.
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
 		if errors.As(err, &apiErr) {
-			var item *Item
-			err = json.Unmarshal(apiErr.Body(), &item)
-			if err != nil {
-				return nil, fmt.Errorf("can't describe item: %v, error: %v", itemName, string(apiErr.Body()))
-			}
-		} else {
-			return nil, fmt.Errorf("can't describe item: %w", err)
+			return nil, fmt.Errorf("can't describe item: %v, error: %v", itemName, string(apiErr.Body()))
 		}
+		return nil, fmt.Errorf("can't describe item: %w", err)
 	}
 	defer res.Body.Close()
 
@@ -230,11 +236,73 @@ func (a *akeylessBase) GetDynamicSecrets(ctx context.Context, secretName, token
 	}
 	defer res.Body.Close()
 
-	out, err := json.Marshal(gsvOut)
+	value, expiration, err := dynamicSecretValue(gsvOut, a.dynamicSecretFormat, time.Now())
 	if err != nil {
-		return "", fmt.Errorf("can't marshal dynamic secret value: %w", err)
+		return "", err
+	}
+	if !expiration.IsZero() && (a.expiration.IsZero() || expiration.Before(a.expiration)) {
+		a.expiration = expiration
+	}
+	return value, nil
+}
+
+func (a *akeylessBase) Expiration() time.Time {
+	return a.expiration
+}
+
+// dynamicSecretValue returns the credentials issued by a dynamic secret producer and the time they expire at,
+// which is the zero time if the producer did not return a TTL.
+// With the Flatten format, the credentials are unpacked into a single JSON object. If the producer returned
+// a TTL, its length in seconds and the absolute expiration time of the credentials are added as `ttl` and `expiration`.
+// Otherwise, the response of the producer is returned as is.
+func dynamicSecretValue(gsvOut map[string]string, format esv1beta1.AkeylessDynamicSecretFormat, now time.Time) (string, time.Time, error) {
+	values := make(map[string]string, len(gsvOut))
+	for k, v := range gsvOut {
+		values[k] = v
+	}
+	if raw, ok := gsvOut["value"]; ok {
+		var inner map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &inner); err == nil {
+			delete(values, "value")
+			for k, v := range inner {
+				str, err := stringifyValue(v)
+				if err != nil {
+					return "", time.Time{}, fmt.Errorf("can't marshal dynamic secret value: %w", err)
+				}
+				values[k] = str
+			}
+		}
+	}
+	var expiration time.Time
+	if ttl, ok := values[dynamicSecretTTLKey]; ok {
+		if minutes, err := strconv.Atoi(ttl); err == nil {
+			expiration = now.Add(time.Duration(minutes) * time.Minute).UTC()
+			values["ttl"] = strconv.Itoa(minutes * 60)
+			values["expiration"] = expiration.Format(time.RFC3339)
+		}
 	}
 
+	var out []byte
+	var err error
+	if format == esv1beta1.AkeylessDynamicSecretFormatFlatten {
+		out, err = json.Marshal(values)
+	} else {
+		out, err = json.Marshal(gsvOut)
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("can't marshal dynamic secret value: %w", err)
+	}
+	return string(out), expiration, nil
+}
+
+func stringifyValue(v interface{}) (string, error) {
+	if str, ok := v.(string); ok {
+		return str, nil
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
 	return string(out), nil
 }
 
@@ -267,6 +335,105 @@ func (a *akeylessBase) GetStaticSecret(ctx context.Context, secretName, token st
 	return val, nil
 }
 
+func (a *akeylessBase) CreateSecret(ctx context.Context, remoteKey, data string, tags []string, protectionKey, token string) error {
+	body := akeyless.CreateSecret{
+		Name:  remoteKey,
+		Value: data,
+	}
+	if len(tags) > 0 {
+		body.Tags = &tags
+	}
+	if protectionKey != "" {
+		body.ProtectionKey = &protectionKey
+	}
+	if strings.HasPrefix(token, "u-") {
+		body.UidToken = &token
+	} else {
+		body.Token = &token
+	}
+
+	_, res, err := a.RestAPI.CreateSecret(ctx).Body(body).Execute()
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMCreateSecret, err)
+	if err != nil {
+		if errors.As(err, &apiErr) {
+			return fmt.Errorf("can't create secret: %v", string(apiErr.Body()))
+		}
+		return fmt.Errorf("can't create secret: %w", err)
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (a *akeylessBase) UpdateSecret(ctx context.Context, remoteKey, data string, tags []string, token string) error {
+	body := akeyless.UpdateSecretVal{
+		Name:  remoteKey,
+		Value: data,
+	}
+	if strings.HasPrefix(token, "u-") {
+		body.UidToken = &token
+	} else {
+		body.Token = &token
+	}
+
+	_, res, err := a.RestAPI.UpdateSecretVal(ctx).Body(body).Execute()
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMUpdateSecretVal, err)
+	if err != nil {
+		if errors.As(err, &apiErr) {
+			return fmt.Errorf("can't update secret value: %v", string(apiErr.Body()))
+		}
+		return fmt.Errorf("can't update secret value: %w", err)
+	}
+	defer res.Body.Close()
+
+	if len(tags) == 0 {
+		return nil
+	}
+	itemBody := akeyless.UpdateItem{
+		Name:   remoteKey,
+		AddTag: &tags,
+	}
+	if strings.HasPrefix(token, "u-") {
+		itemBody.UidToken = &token
+	} else {
+		itemBody.Token = &token
+	}
+	_, itemRes, err := a.RestAPI.UpdateItem(ctx).Body(itemBody).Execute()
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMUpdateItem, err)
+	if err != nil {
+		if errors.As(err, &apiErr) {
+			return fmt.Errorf("can't update secret tags: %v", string(apiErr.Body()))
+		}
+		return fmt.Errorf("can't update secret tags: %w", err)
+	}
+	defer itemRes.Body.Close()
+
+	return nil
+}
+
+func (a *akeylessBase) DeleteSecret(ctx context.Context, remoteKey, token string) error {
+	body := akeyless.DeleteItem{
+		Name: remoteKey,
+	}
+	if strings.HasPrefix(token, "u-") {
+		body.UidToken = &token
+	} else {
+		body.Token = &token
+	}
+
+	_, res, err := a.RestAPI.DeleteItem(ctx).Body(body).Execute()
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDeleteItem, err)
+	if err != nil {
+		if errors.As(err, &apiErr) {
+			return fmt.Errorf("can't delete secret: %v", string(apiErr.Body()))
+		}
+		return fmt.Errorf("can't delete secret: %w", err)
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
 func (a *akeylessBase) getCloudID(provider, accTypeParam string) (string, error) {
 	var cloudID string
 	var err error
@@ -16,14 +16,20 @@ package akeyless
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
 	fakeakeyless "github.com/external-secrets/external-secrets/pkg/provider/akeyless/fake"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
 )
 
 type akeylessTestCase struct {
@@ -267,6 +273,202 @@ func TestGetSecretMap(t *testing.T) {
 	}
 }
 
+func TestPushSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("s3cr3t"),
+		},
+	}
+
+	tests := []struct {
+		name          string
+		items         map[string]*fakeakeyless.Item
+		data          testingfake.PushSecretData
+		expectError   string
+		expectedValue string
+		expectedTags  []string
+		expectedKey   string
+	}{
+		{
+			name:          "create static secret with metadata",
+			data:          testingfake.PushSecretData{SecretKey: "password", RemoteKey: "/app/db", Metadata: &apiextensionsv1.JSON{Raw: []byte(`{"tags":["team-a"],"protectionKey":"my-key"}`)}},
+			expectedValue: "s3cr3t",
+			expectedTags:  []string{"team-a"},
+			expectedKey:   "my-key",
+		},
+		{
+			name:          "create static secret from whole secret",
+			data:          testingfake.PushSecretData{RemoteKey: "/app/db"},
+			expectedValue: `{"password":"s3cr3t","username":"admin"}`,
+		},
+		{
+			name:          "set property of existing static secret",
+			items:         map[string]*fakeakeyless.Item{"/app/db": {Type: "STATIC_SECRET", Value: `{"username":"admin"}`}},
+			data:          testingfake.PushSecretData{SecretKey: "password", RemoteKey: "/app/db", Property: "password"},
+			expectedValue: `{"password":"s3cr3t","username":"admin"}`,
+		},
+		{
+			name:        "refuse to overwrite dynamic secret",
+			items:       map[string]*fakeakeyless.Item{"/app/db": {Type: "DYNAMIC_SECRET"}},
+			data:        testingfake.PushSecretData{SecretKey: "password", RemoteKey: "/app/db"},
+			expectError: "only static secrets are supported",
+		},
+		{
+			name:        "unknown metadata",
+			data:        testingfake.PushSecretData{SecretKey: "password", RemoteKey: "/app/db", Metadata: &apiextensionsv1.JSON{Raw: []byte(`{"labels":{}}`)}},
+			expectError: "failed to decode PushSecret metadata",
+		},
+		{
+			name:        "missing secret key",
+			data:        testingfake.PushSecretData{SecretKey: "token", RemoteKey: "/app/db"},
+			expectError: "cannot find secret data for key",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := &fakeakeyless.AkeylessMockClient{}
+			for name, item := range tc.items {
+				mockClient.WithItem(name, item)
+			}
+			sm := Akeyless{Client: mockClient}
+
+			err := sm.PushSecret(context.Background(), secret, tc.data)
+			if !ErrorContains(err, tc.expectError) {
+				t.Fatalf("unexpected error: %v, expected: '%s'", err, tc.expectError)
+			}
+			if tc.expectError != "" {
+				return
+			}
+			item, ok := mockClient.GetItem(tc.data.RemoteKey)
+			if !ok {
+				t.Fatalf("expected item %s to be created", tc.data.RemoteKey)
+			}
+			if item.Value != tc.expectedValue {
+				t.Errorf("unexpected value: expected %s, got %s", tc.expectedValue, item.Value)
+			}
+			if !reflect.DeepEqual(item.Tags, tc.expectedTags) {
+				t.Errorf("unexpected tags: expected %v, got %v", tc.expectedTags, item.Tags)
+			}
+			if item.ProtectionKey != tc.expectedKey {
+				t.Errorf("unexpected protection key: expected %s, got %s", tc.expectedKey, item.ProtectionKey)
+			}
+		})
+	}
+}
+
+func TestDeleteSecret(t *testing.T) {
+	mockClient := &fakeakeyless.AkeylessMockClient{}
+	mockClient.WithItem("/app/db", &fakeakeyless.Item{Type: "STATIC_SECRET", Value: `{"password":"s3cr3t","username":"admin"}`})
+	sm := Akeyless{Client: mockClient}
+	ctx := context.Background()
+
+	exists, err := sm.SecretExists(ctx, testingfake.PushSecretData{RemoteKey: "/app/db", Property: "password"})
+	if err != nil || !exists {
+		t.Fatalf("expected property to exist, got %v, %v", exists, err)
+	}
+
+	err = sm.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "/app/db", Property: "password"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	item, _ := mockClient.GetItem("/app/db")
+	if item.Value != `{"username":"admin"}` {
+		t.Errorf("unexpected value after deleting property: %s", item.Value)
+	}
+	exists, err = sm.SecretExists(ctx, testingfake.PushSecretData{RemoteKey: "/app/db", Property: "password"})
+	if err != nil || exists {
+		t.Fatalf("expected property to be deleted, got %v, %v", exists, err)
+	}
+
+	err = sm.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "/app/db", Property: "username"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := mockClient.GetItem("/app/db"); ok {
+		t.Errorf("expected item to be deleted with its last property")
+	}
+
+	err = sm.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "/app/db"})
+	if err != nil {
+		t.Fatalf("deleting a missing secret must not fail: %v", err)
+	}
+}
+
+func TestDeleteSecretDescribeError(t *testing.T) {
+	mockClient := &fakeakeyless.AkeylessMockClient{}
+	mockClient.WithItem("/app/db", &fakeakeyless.Item{Type: "STATIC_SECRET", Value: "s3cr3t"})
+	mockClient.WithDescribeError(errors.New("can't describe item: /app/db, error: access denied"))
+	sm := Akeyless{Client: mockClient}
+	ctx := context.Background()
+
+	err := sm.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "/app/db"})
+	if err == nil {
+		t.Fatalf("expected DeleteSecret to fail if the item can not be described")
+	}
+	if _, ok := mockClient.GetItem("/app/db"); !ok {
+		t.Errorf("expected item to be kept")
+	}
+
+	exists, err := sm.SecretExists(ctx, testingfake.PushSecretData{RemoteKey: "/app/db"})
+	if err == nil {
+		t.Fatalf("expected SecretExists to fail if the item can not be described, got %v", exists)
+	}
+}
+
+func TestDynamicSecretValue(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	gsvOut := map[string]string{
+		"value": `{"user":"tmp-user","password":"tmp-pass","ttl_in_minutes":"60","id":"tmp-id"}`,
+	}
+	out, expiration, err := dynamicSecretValue(gsvOut, esv1beta1.AkeylessDynamicSecretFormatRaw, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"value":"{\"user\":\"tmp-user\",\"password\":\"tmp-pass\",\"ttl_in_minutes\":\"60\",\"id\":\"tmp-id\"}"}`
+	if out != expected {
+		t.Errorf("unexpected dynamic secret value: expected %s, got %s", expected, out)
+	}
+	if want := now.Add(time.Hour); !expiration.Equal(want) {
+		t.Errorf("unexpected expiration: expected %v, got %v", want, expiration)
+	}
+
+	out, _, err = dynamicSecretValue(gsvOut, esv1beta1.AkeylessDynamicSecretFormatFlatten, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = `{"expiration":"2024-01-01T13:00:00Z","id":"tmp-id","password":"tmp-pass","ttl":"3600","ttl_in_minutes":"60","user":"tmp-user"}`
+	if out != expected {
+		t.Errorf("unexpected dynamic secret value: expected %s, got %s", expected, out)
+	}
+
+	out, expiration, err = dynamicSecretValue(map[string]string{"value": "not-json"}, esv1beta1.AkeylessDynamicSecretFormatFlatten, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != `{"value":"not-json"}` {
+		t.Errorf("unexpected dynamic secret value: %s", out)
+	}
+	if !expiration.IsZero() {
+		t.Errorf("unexpected expiration: %v", expiration)
+	}
+}
+
+func TestExpiration(t *testing.T) {
+	expiration := time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)
+	mockClient := &fakeakeyless.AkeylessMockClient{}
+	mockClient.WithExpiration(expiration)
+	var sm esv1beta1.SecretsClient = &Akeyless{Client: mockClient}
+	expClient, ok := sm.(esv1beta1.SecretsExpirationClient)
+	if !ok {
+		t.Fatalf("expected Akeyless to implement SecretsExpirationClient")
+	}
+	if got := expClient.Expiration(); !got.Equal(expiration) {
+		t.Errorf("unexpected expiration: expected %v, got %v", expiration, got)
+	}
+}
+
 func ErrorContains(out error, want string) bool {
 	if out == nil {
 		return want == ""
@@ -16,10 +16,25 @@ package fake
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/akeylesslabs/akeyless-go/v3"
 )
 
 type AkeylessMockClient struct {
-	getSecret func(secretName, token string, version int32) (string, error)
+	getSecret   func(secretName, token string, version int32) (string, error)
+	items       map[string]*Item
+	describeErr error
+	expiration  time.Time
+}
+
+// Item is an Akeyless item stored by the mock client.
+type Item struct {
+	Type          string
+	Value         string
+	Tags          []string
+	ProtectionKey string
 }
 
 func (mc *AkeylessMockClient) TokenFromSecretRef(_ context.Context) (string, error) {
@@ -27,9 +42,81 @@ func (mc *AkeylessMockClient) TokenFromSecretRef(_ context.Context) (string, err
 }
 
 func (mc *AkeylessMockClient) GetSecretByType(_ context.Context, secretName, token string, version int32) (string, error) {
+	if item, ok := mc.items[secretName]; ok {
+		return item.Value, nil
+	}
 	return mc.getSecret(secretName, token, version)
 }
 
+func (mc *AkeylessMockClient) DescribeItem(_ context.Context, itemName, _ string) (*akeyless.Item, error) {
+	if mc.describeErr != nil {
+		return nil, mc.describeErr
+	}
+	item, ok := mc.items[itemName]
+	if !ok {
+		return nil, nil
+	}
+	return &akeyless.Item{
+		ItemName: &itemName,
+		ItemType: &item.Type,
+		ItemTags: &item.Tags,
+	}, nil
+}
+
+func (mc *AkeylessMockClient) CreateSecret(_ context.Context, remoteKey, data string, tags []string, protectionKey, _ string) error {
+	if _, ok := mc.items[remoteKey]; ok {
+		return fmt.Errorf("item %s already exists", remoteKey)
+	}
+	mc.WithItem(remoteKey, &Item{Type: "STATIC_SECRET", Value: data, Tags: tags, ProtectionKey: protectionKey})
+	return nil
+}
+
+func (mc *AkeylessMockClient) UpdateSecret(_ context.Context, remoteKey, data string, tags []string, _ string) error {
+	item, ok := mc.items[remoteKey]
+	if !ok {
+		return fmt.Errorf("item %s not found", remoteKey)
+	}
+	item.Value = data
+	item.Tags = append(item.Tags, tags...)
+	return nil
+}
+
+func (mc *AkeylessMockClient) DeleteSecret(_ context.Context, remoteKey, _ string) error {
+	if _, ok := mc.items[remoteKey]; !ok {
+		return fmt.Errorf("item %s not found", remoteKey)
+	}
+	delete(mc.items, remoteKey)
+	return nil
+}
+
+// WithItem stores an item that is returned instead of the configured value.
+func (mc *AkeylessMockClient) WithItem(name string, item *Item) {
+	if mc.items == nil {
+		mc.items = make(map[string]*Item)
+	}
+	mc.items[name] = item
+}
+
+// WithDescribeError makes DescribeItem fail, e.g. to simulate a permission error.
+func (mc *AkeylessMockClient) WithDescribeError(err error) {
+	mc.describeErr = err
+}
+
+// WithExpiration sets the expiration of the dynamic secrets read by the mock client.
+func (mc *AkeylessMockClient) WithExpiration(expiration time.Time) {
+	mc.expiration = expiration
+}
+
+func (mc *AkeylessMockClient) Expiration() time.Time {
+	return mc.expiration
+}
+
+// GetItem returns an item stored by the mock client.
+func (mc *AkeylessMockClient) GetItem(name string) (*Item, bool) {
+	item, ok := mc.items[name]
+	return item, ok
+}
+
 func (mc *AkeylessMockClient) ListSecrets(_ context.Context, _, _, _ string) ([]string, error) {
 	return nil, nil
 }
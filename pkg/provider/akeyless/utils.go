@@ -32,6 +32,8 @@ const (
 	errMissingProvider              = "storeSpec is missing provider"
 	errInvalidProvider              = "invalid provider spec. Missing Akeyless field in store %s"
 	errJSONSecretUnmarshal          = "unable to unmarshal secret: %w"
+	errJSONSecretMarshal            = "unable to marshal secret: %w"
+	errNotStaticSecret              = "cannot push to item %q of type %s, only static secrets are supported"
 	errUninitalizedAkeylessProvider = "provider akeyless is not initialized"
 	errInvalidAkeylessURL           = "invalid akeyless GW API URL"
 	errInvalidAkeylessAccessIDName  = "missing akeyless accessID name"
@@ -160,6 +160,7 @@ func New(ctx context.Context, store esv1beta1.GenericStore, kube client.Client,
 // * service-account token authentication via AssumeRoleWithWebIdentity
 // * static credentials from a Kind=Secret, optionally with doing a AssumeRole.
 // * sdk default provider chain, see: https://docs.aws.amazon.com/sdk-for-java/v1/developer-guide/credentials.html#credentials-default
+// References are resolved with the store kind of the generator, see resolvers.GeneratorStoreKind.
 func NewGeneratorSession(ctx context.Context, auth esv1beta1.AWSAuth, role, region string, kube client.Client, namespace string, assumeRoler STSProvider, jwtProvider jwtProviderFactory) (*session.Session, error) {
 	var creds *credentials.Credentials
 	var err error
@@ -167,7 +168,8 @@ func NewGeneratorSession(ctx context.Context, auth esv1beta1.AWSAuth, role, regi
 	// use credentials via service account token
 	jwtAuth := auth.JWTAuth
 	if jwtAuth != nil {
-		creds, err = credsFromServiceAccount(ctx, auth, region, false, kube, namespace, jwtProvider)
+		isClusterKind := resolvers.GeneratorStoreKind(ctx) == esv1beta1.ClusterSecretStoreKind
+		creds, err = credsFromServiceAccount(ctx, auth, region, isClusterKind, kube, namespace, jwtProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -177,7 +179,7 @@ func NewGeneratorSession(ctx context.Context, auth esv1beta1.AWSAuth, role, regi
 	secretRef := auth.SecretRef
 	if secretRef != nil {
 		log.V(1).Info("using credentials from secretRef")
-		creds, err = credsFromSecretRef(ctx, auth, "", kube, namespace)
+		creds, err = credsFromSecretRef(ctx, auth, resolvers.GeneratorStoreKind(ctx), kube, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -17,6 +17,7 @@ package delinea
 import (
 	"context"
 	"errors"
+	"net/url"
 
 	"github.com/DelineaXPM/dsv-sdk-go/v2/vault"
 	kubeClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +39,11 @@ var (
 	errMissingSecretName             = errors.New("must specify a secret name")
 	errMissingSecretKey              = errors.New("must specify a secret key")
 	errClusterStoreRequiresNamespace = errors.New("when using a ClusterSecretStore, namespaces must be explicitly set")
+	errSecretServerConflict          = errors.New("secretServer cannot be combined with tenant, clientId or clientSecret")
+	errEmptyServerURL                = errors.New("secretServer.serverURL must not be empty")
+	errInvalidServerURL              = errors.New("secretServer.serverURL must be an absolute URL")
+	errEmptyUsername                 = errors.New("secretServer.username must be set")
+	errEmptyPassword                 = errors.New("secretServer.password must be set")
 )
 
 type Provider struct{}
@@ -60,6 +66,10 @@ func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore,
 		return nil, errClusterStoreRequiresNamespace
 	}
 
+	if cfg.SecretServer != nil {
+		return newSecretServerClient(ctx, store.GetKind(), cfg.SecretServer, kube, namespace)
+	}
+
 	clientID, err := loadConfigSecret(ctx, store.GetKind(), cfg.ClientID, kube, namespace)
 	if err != nil {
 		return nil, err
@@ -132,12 +142,15 @@ func validateSecretRef(ref *esv1beta1.DelineaProviderSecretRef) error {
 }
 
 func doesConfigDependOnNamespace(cfg *esv1beta1.DelineaProvider) bool {
-	if cfg.ClientID.SecretRef != nil && cfg.ClientID.SecretRef.Namespace == nil {
-		return true
+	refs := []*esv1beta1.DelineaProviderSecretRef{cfg.ClientID, cfg.ClientSecret}
+	if cfg.SecretServer != nil {
+		refs = []*esv1beta1.DelineaProviderSecretRef{cfg.SecretServer.Username, cfg.SecretServer.Password}
 	}
 
-	if cfg.ClientSecret.SecretRef != nil && cfg.ClientSecret.SecretRef.Namespace == nil {
-		return true
+	for _, ref := range refs {
+		if ref != nil && ref.SecretRef != nil && ref.SecretRef.Namespace == nil {
+			return true
+		}
 	}
 
 	return false
@@ -154,6 +167,13 @@ func getConfig(store esv1beta1.GenericStore) (*esv1beta1.DelineaProvider, error)
 	}
 	cfg := storeSpec.Provider.Delinea
 
+	if cfg.SecretServer != nil {
+		if err := validateSecretServerConfig(store, cfg); err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	}
+
 	if cfg.Tenant == "" {
 		return nil, errEmptyTenant
 	}
@@ -179,6 +199,34 @@ func getConfig(store esv1beta1.GenericStore) (*esv1beta1.DelineaProvider, error)
 	return cfg, nil
 }
 
+func validateSecretServerConfig(store esv1beta1.GenericStore, cfg *esv1beta1.DelineaProvider) error {
+	if cfg.Tenant != "" || cfg.ClientID != nil || cfg.ClientSecret != nil {
+		return errSecretServerConflict
+	}
+
+	if cfg.SecretServer.ServerURL == "" {
+		return errEmptyServerURL
+	}
+
+	if u, err := url.Parse(cfg.SecretServer.ServerURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return errInvalidServerURL
+	}
+
+	if cfg.SecretServer.Username == nil {
+		return errEmptyUsername
+	}
+
+	if cfg.SecretServer.Password == nil {
+		return errEmptyPassword
+	}
+
+	if err := validateStoreSecretRef(store, cfg.SecretServer.Username); err != nil {
+		return err
+	}
+
+	return validateStoreSecretRef(store, cfg.SecretServer.Password)
+}
+
 func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
 	_, err := getConfig(store)
 	return nil, err
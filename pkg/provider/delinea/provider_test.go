@@ -54,6 +54,17 @@ func TestDoesConfigDependOnNamespace(t *testing.T) {
 			},
 			want: true,
 		},
+		"true when secret server password references a secret without explicit namespace": {
+			cfg: esv1beta1.DelineaProvider{
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					Username: &esv1beta1.DelineaProviderSecretRef{SecretRef: nil},
+					Password: &esv1beta1.DelineaProviderSecretRef{
+						SecretRef: &v1.SecretKeySelector{Name: "foo"},
+					},
+				},
+			},
+			want: true,
+		},
 		"false when neither client ID nor secret reference a secret": {
 			cfg: esv1beta1.DelineaProvider{
 				ClientID:     &esv1beta1.DelineaProviderSecretRef{SecretRef: nil},
@@ -143,6 +154,74 @@ func TestValidateStore(t *testing.T) {
 			},
 			want: nil,
 		},
+		"invalid with secret server and tenant": {
+			cfg: esv1beta1.DelineaProvider{
+				Tenant: "foo",
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					ServerURL: "https://example.com/SecretServer",
+					Username:  validSecretRefUsingValue,
+					Password:  validSecretRefUsingValue,
+				},
+			},
+			want: errSecretServerConflict,
+		},
+		"invalid with secret server without serverURL": {
+			cfg: esv1beta1.DelineaProvider{
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					Username: validSecretRefUsingValue,
+					Password: validSecretRefUsingValue,
+				},
+			},
+			want: errEmptyServerURL,
+		},
+		"invalid with secret server with relative serverURL": {
+			cfg: esv1beta1.DelineaProvider{
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					ServerURL: "example.com/SecretServer",
+					Username:  validSecretRefUsingValue,
+					Password:  validSecretRefUsingValue,
+				},
+			},
+			want: errInvalidServerURL,
+		},
+		"invalid with secret server without username": {
+			cfg: esv1beta1.DelineaProvider{
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					ServerURL: "https://example.com/SecretServer",
+					Password:  validSecretRefUsingValue,
+				},
+			},
+			want: errEmptyUsername,
+		},
+		"invalid with secret server without password": {
+			cfg: esv1beta1.DelineaProvider{
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					ServerURL: "https://example.com/SecretServer",
+					Username:  validSecretRefUsingValue,
+				},
+			},
+			want: errEmptyPassword,
+		},
+		"invalid with secret server with ambiguous password": {
+			cfg: esv1beta1.DelineaProvider{
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					ServerURL: "https://example.com/SecretServer",
+					Username:  validSecretRefUsingValue,
+					Password:  ambiguousSecretRef,
+				},
+			},
+			want: errSecretRefAndValueConflict,
+		},
+		"valid with secret server": {
+			cfg: esv1beta1.DelineaProvider{
+				SecretServer: &esv1beta1.DelineaSecretServer{
+					ServerURL: "https://example.com/SecretServer",
+					Username:  validSecretRefUsingValue,
+					Password:  validSecretRefUsingValue,
+				},
+			},
+			want: nil,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -15,6 +15,8 @@ limitations under the License.
 package delinea
 
 import (
+	"context"
+
 	"github.com/DelineaXPM/dsv-sdk-go/v2/vault"
 )
 
@@ -24,3 +26,44 @@ import (
 type secretAPI interface {
 	Secret(path string) (*vault.Secret, error)
 }
+
+// secretServerAPI represents the subset of the Delinea Secret Server REST API
+// which is used by the provider.
+// See https://updates.thycotic.net/secretserver/restapiguide/ for full API documentation.
+type secretServerAPI interface {
+	// Secret returns the secret with the given id.
+	Secret(ctx context.Context, id int) (*serverSecret, error)
+	// SecretByPath returns the secret with the given folder path, e.g. `\Folder\Secret`.
+	SecretByPath(ctx context.Context, path string) (*serverSecret, error)
+	// SecretFile returns the content of a file attachment field of a secret.
+	SecretFile(ctx context.Context, id int, slug string) ([]byte, error)
+	// FolderByPath returns the id of the folder with the given path, e.g. `\Folder`.
+	FolderByPath(ctx context.Context, path string) (int, error)
+	// SearchSecrets lists the secrets matching searchText, optionally limited to a folder and its sub folders.
+	SearchSecrets(ctx context.Context, folderID *int, searchText string) ([]serverSecretSummary, error)
+	// Authenticate requests a new access token.
+	Authenticate(ctx context.Context) error
+}
+
+// serverSecret is a Secret Server secret. The fields of a secret are defined by its template.
+type serverSecret struct {
+	ID       int                `json:"id"`
+	Name     string             `json:"name"`
+	FolderID int                `json:"folderId"`
+	Items    []serverSecretItem `json:"items"`
+}
+
+// serverSecretItem is a single field of a Secret Server secret.
+type serverSecretItem struct {
+	FieldName string `json:"fieldName"`
+	Slug      string `json:"slug"`
+	ItemValue string `json:"itemValue"`
+	IsFile    bool   `json:"isFile"`
+}
+
+// serverSecretSummary is a search result.
+type serverSecretSummary struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	FolderID int    `json:"folderId"`
+}
@@ -0,0 +1,244 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delinea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kubeClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+)
+
+// secretServerClient is the SecretsClient for Delinea Secret Server.
+type secretServerClient struct {
+	api secretServerAPI
+}
+
+var _ esv1beta1.SecretsClient = &secretServerClient{}
+
+func newSecretServerClient(
+	ctx context.Context,
+	storeKind string,
+	cfg *esv1beta1.DelineaSecretServer,
+	kube kubeClient.Client,
+	namespace string) (esv1beta1.SecretsClient, error) {
+	username, err := loadConfigSecret(ctx, storeKind, cfg.Username, kube, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := loadConfigSecret(ctx, storeKind, cfg.Password, kube, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	api, err := newSecretServer(cfg.ServerURL, username, password, cfg.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretServerClient{
+		api: api,
+	}, nil
+}
+
+// GetSecret supports two types:
+//  1. get all fields of the secret as json-encoded value
+//     by leaving the ref.Property empty.
+//  2. get a single field of the secret by its slug or field name.
+//
+// ref.Key is either the numeric id of the secret or its path, e.g. `/Folder/Secret`.
+func (c *secretServerClient) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	secret, err := c.getSecret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.Property == "" {
+		return c.secretJSON(ctx, secret)
+	}
+
+	for _, item := range secret.Items {
+		if item.Slug == ref.Property || strings.EqualFold(item.FieldName, ref.Property) {
+			return c.itemValue(ctx, secret, item)
+		}
+	}
+
+	return nil, esv1beta1.NoSecretError{}
+}
+
+// GetSecretMap returns all fields of a secret keyed by their slug.
+func (c *secretServerClient) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	secret, err := c.getSecret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.secretValues(ctx, secret)
+}
+
+// GetAllSecrets returns all secrets in the folder given by ref.Path whose name matches ref.Name.
+// Each secret is returned as json-encoded value keyed by its name.
+func (c *secretServerClient) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if len(ref.Tags) > 0 {
+		return nil, errors.New("find by tags is not supported by Delinea Secret Server")
+	}
+
+	var folderID *int
+	if ref.Path != nil && *ref.Path != "" {
+		id, err := c.folderID(ctx, *ref.Path)
+		if err != nil {
+			return nil, err
+		}
+		folderID = &id
+	}
+
+	var (
+		matcher    *find.Matcher
+		searchText string
+	)
+	if ref.Name != nil {
+		var err error
+		matcher, err = find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		// only plain text can be searched for on the server, regular expressions are matched below
+		if regexp.QuoteMeta(ref.Name.RegExp) == ref.Name.RegExp {
+			searchText = ref.Name.RegExp
+		}
+	}
+
+	summaries, err := c.api.SearchSecrets(ctx, folderID, searchText)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string][]byte, len(summaries))
+	for _, summary := range summaries {
+		if matcher != nil && !matcher.MatchName(summary.Name) {
+			continue
+		}
+		secret, err := c.api.Secret(ctx, summary.ID)
+		if err != nil {
+			return nil, err
+		}
+		secrets[summary.Name], err = c.secretJSON(ctx, secret)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return secrets, nil
+}
+
+func (c *secretServerClient) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New("pushing secrets is not supported by Delinea Secret Server")
+}
+
+func (c *secretServerClient) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New("deleting secrets is not supported by Delinea Secret Server")
+}
+
+func (c *secretServerClient) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New("not implemented")
+}
+
+// Validate checks that the configured credentials can be used to authenticate.
+func (c *secretServerClient) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.api.Authenticate(context.Background()); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *secretServerClient) Close(context.Context) error {
+	return nil
+}
+
+// getSecret retrieves the secret referenced by ref either by id or by path.
+func (c *secretServerClient) getSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (*serverSecret, error) {
+	if ref.Version != "" {
+		return nil, errors.New("specifying a version is not yet supported")
+	}
+	if id, err := strconv.Atoi(ref.Key); err == nil {
+		return c.api.Secret(ctx, id)
+	}
+
+	return c.api.SecretByPath(ctx, toSecretServerPath(ref.Key))
+}
+
+func (c *secretServerClient) folderID(ctx context.Context, path string) (int, error) {
+	if id, err := strconv.Atoi(path); err == nil {
+		return id, nil
+	}
+
+	return c.api.FolderByPath(ctx, toSecretServerPath(path))
+}
+
+func (c *secretServerClient) itemValue(ctx context.Context, secret *serverSecret, item serverSecretItem) ([]byte, error) {
+	if item.IsFile {
+		return c.api.SecretFile(ctx, secret.ID, item.Slug)
+	}
+
+	return []byte(item.ItemValue), nil
+}
+
+func (c *secretServerClient) secretValues(ctx context.Context, secret *serverSecret) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(secret.Items))
+	for _, item := range secret.Items {
+		value, err := c.itemValue(ctx, secret, item)
+		if err != nil {
+			return nil, err
+		}
+		values[item.Slug] = value
+	}
+
+	return values, nil
+}
+
+func (c *secretServerClient) secretJSON(ctx context.Context, secret *serverSecret) ([]byte, error) {
+	values, err := c.secretValues(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(values))
+	for slug, value := range values {
+		fields[slug] = string(value)
+	}
+
+	return json.Marshal(fields)
+}
+
+// toSecretServerPath converts a path using forward slashes to the
+// backslash separated form used by Secret Server.
+func toSecretServerPath(path string) string {
+	path = strings.ReplaceAll(path, "/", `\`)
+	if !strings.HasPrefix(path, `\`) {
+		path = `\` + path
+	}
+
+	return path
+}
@@ -0,0 +1,268 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delinea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	secretServerTokenPath = "/oauth2/token"
+	secretServerAPIPath   = "/api/v1"
+	secretServerPageSize  = 100
+	secretServerTimeout   = 30 * time.Second
+
+	// refresh the access token a bit before it actually expires.
+	secretServerTokenLeeway = 30 * time.Second
+
+	// limit how much of an error response ends up in error messages.
+	secretServerMaxErrorBody = 512
+)
+
+// secretServer implements secretServerAPI using the Secret Server REST API.
+type secretServer struct {
+	httpClient *http.Client
+	baseURL    string
+	username   string
+	password   string
+	domain     string
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+var _ secretServerAPI = &secretServer{}
+
+type secretServerToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+type secretServerSearchResult struct {
+	Records  []serverSecretSummary `json:"records"`
+	HasNext  bool                  `json:"hasNext"`
+	NextSkip int                   `json:"nextSkip"`
+}
+
+type secretServerFolder struct {
+	ID int `json:"id"`
+}
+
+func newSecretServer(serverURL, username, password, domain string) (*secretServer, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errInvalidServerURL
+	}
+
+	return &secretServer{
+		httpClient: &http.Client{Timeout: secretServerTimeout},
+		baseURL:    strings.TrimSuffix(u.String(), "/"),
+		username:   username,
+		password:   password,
+		domain:     domain,
+	}, nil
+}
+
+// Authenticate requests a new access token using the OAuth password grant.
+func (s *secretServer) Authenticate(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.authenticate(ctx)
+}
+
+func (s *secretServer) authenticate(ctx context.Context) error {
+	form := url.Values{
+		"grant_type": {"password"},
+		"username":   {s.username},
+		"password":   {s.password},
+	}
+	if s.domain != "" {
+		form.Set("domain", s.domain)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+secretServerTokenPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var token secretServerToken
+	if err := s.do(req, &token); err != nil {
+		return fmt.Errorf("unable to authenticate with Secret Server: %w", err)
+	}
+	if token.AccessToken == "" {
+		return fmt.Errorf("unable to authenticate with Secret Server: empty access token")
+	}
+	s.token = token.AccessToken
+	s.tokenExpiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - secretServerTokenLeeway)
+
+	return nil
+}
+
+func (s *secretServer) accessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == "" || time.Now().After(s.tokenExpiry) {
+		if err := s.authenticate(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	return s.token, nil
+}
+
+func (s *secretServer) Secret(ctx context.Context, id int) (*serverSecret, error) {
+	var secret serverSecret
+	if err := s.get(ctx, "/secrets/"+strconv.Itoa(id), nil, &secret); err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
+func (s *secretServer) SecretByPath(ctx context.Context, path string) (*serverSecret, error) {
+	var secret serverSecret
+	if err := s.get(ctx, "/secrets/0", url.Values{"secretPath": {path}}, &secret); err != nil {
+		return nil, err
+	}
+
+	return &secret, nil
+}
+
+func (s *secretServer) SecretFile(ctx context.Context, id int, slug string) ([]byte, error) {
+	req, err := s.newRequest(ctx, fmt.Sprintf("/secrets/%d/fields/%s", id, url.PathEscape(slug)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func (s *secretServer) FolderByPath(ctx context.Context, path string) (int, error) {
+	var folder secretServerFolder
+	if err := s.get(ctx, "/folders/0", url.Values{"folderPath": {path}}, &folder); err != nil {
+		return 0, err
+	}
+
+	return folder.ID, nil
+}
+
+func (s *secretServer) SearchSecrets(ctx context.Context, folderID *int, searchText string) ([]serverSecretSummary, error) {
+	query := url.Values{
+		"take": {strconv.Itoa(secretServerPageSize)},
+	}
+	if searchText != "" {
+		query.Set("filter.searchText", searchText)
+	}
+	if folderID != nil {
+		query.Set("filter.folderId", strconv.Itoa(*folderID))
+		query.Set("filter.includeSubFolders", "true")
+	}
+
+	var secrets []serverSecretSummary
+	skip := 0
+	for {
+		query.Set("skip", strconv.Itoa(skip))
+		var result secretServerSearchResult
+		if err := s.get(ctx, "/secrets", query, &result); err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, result.Records...)
+		if !result.HasNext || result.NextSkip <= skip {
+			return secrets, nil
+		}
+		skip = result.NextSkip
+	}
+}
+
+func (s *secretServer) newRequest(ctx context.Context, path string, query url.Values) (*http.Request, error) {
+	token, err := s.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u := s.baseURL + secretServerAPIPath + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req, nil
+}
+
+func (s *secretServer) get(ctx context.Context, path string, query url.Values, out any) error {
+	req, err := s.newRequest(ctx, path, query)
+	if err != nil {
+		return err
+	}
+
+	return s.do(req, out)
+}
+
+func (s *secretServer) do(req *http.Request, out any) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// checkResponse maps unsuccessful responses to errors.
+// Secrets or folders which do not exist are reported as esv1beta1.NoSecretError.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, secretServerMaxErrorBody))
+	return fmt.Errorf("unexpected response from Secret Server: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,352 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delinea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	fakeUsername = "user"
+	fakePassword = "pass"
+	fakeToken    = "token"
+)
+
+type fakeSecretServerSecret struct {
+	serverSecret
+	path  string
+	files map[string]string
+}
+
+// fakeSecretServer serves the subset of the Secret Server REST API used by the provider.
+type fakeSecretServer struct {
+	secrets []fakeSecretServerSecret
+	folders map[string]int
+	logins  int
+}
+
+func newFakeSecretServer(t *testing.T) (*fakeSecretServer, *httptest.Server) {
+	t.Helper()
+	f := &fakeSecretServer{
+		folders: map[string]int{`\Apps`: 1, `\Apps\Web`: 2, `\Other`: 3},
+		secrets: []fakeSecretServerSecret{
+			{
+				serverSecret: serverSecret{ID: 10, Name: "db", FolderID: 1, Items: []serverSecretItem{
+					{FieldName: "Username", Slug: "username", ItemValue: "admin"},
+					{FieldName: "Password", Slug: "password", ItemValue: "s3cr3t"},
+				}},
+				path: `\Apps\db`,
+			},
+			{
+				serverSecret: serverSecret{ID: 11, Name: "web-cert", FolderID: 2, Items: []serverSecretItem{
+					{FieldName: "Certificate", Slug: "certificate", IsFile: true},
+					{FieldName: "Notes", Slug: "notes", ItemValue: "web"},
+				}},
+				path:  `\Apps\Web\web-cert`,
+				files: map[string]string{"certificate": "-----BEGIN CERTIFICATE-----"},
+			},
+			{
+				serverSecret: serverSecret{ID: 12, Name: "other-db", FolderID: 3, Items: []serverSecretItem{
+					{FieldName: "Password", Slug: "password", ItemValue: "other"},
+				}},
+				path: `\Other\other-db`,
+			},
+		},
+	}
+	server := httptest.NewServer(http.StripPrefix("/SecretServer", f))
+	t.Cleanup(server.Close)
+
+	return f, server
+}
+
+func (f *fakeSecretServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == secretServerTokenPath {
+		f.login(w, r)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+fakeToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, secretServerAPIPath)
+	query := r.URL.Query()
+	switch {
+	case path == "/folders/0":
+		id, ok := f.folders[query.Get("folderPath")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, secretServerFolder{ID: id})
+	case path == "/secrets":
+		f.search(w, r)
+	case path == "/secrets/0":
+		for _, secret := range f.secrets {
+			if secret.path == query.Get("secretPath") {
+				writeJSON(w, secret.serverSecret)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case strings.HasPrefix(path, "/secrets/"):
+		parts := strings.Split(strings.TrimPrefix(path, "/secrets/"), "/")
+		id, _ := strconv.Atoi(parts[0])
+		for _, secret := range f.secrets {
+			if secret.ID != id {
+				continue
+			}
+			if len(parts) == 3 && parts[1] == "fields" {
+				_, _ = w.Write([]byte(secret.files[parts[2]]))
+				return
+			}
+			writeJSON(w, secret.serverSecret)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeSecretServer) login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.FormValue("grant_type") != "password" ||
+		r.FormValue("username") != fakeUsername || r.FormValue("password") != fakePassword {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+	f.logins++
+	writeJSON(w, secretServerToken{AccessToken: fakeToken, ExpiresIn: 1200})
+}
+
+// search returns one secret per page to exercise paging.
+func (f *fakeSecretServer) search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	folders := map[int]bool{}
+	if folder := query.Get("filter.folderId"); folder != "" {
+		id, _ := strconv.Atoi(folder)
+		folders[id] = true
+		// the fake only knows a single level of sub folders
+		if id == 1 && query.Get("filter.includeSubFolders") == "true" {
+			folders[2] = true
+		}
+	}
+
+	var matches []serverSecretSummary
+	for _, secret := range f.secrets {
+		if len(folders) > 0 && !folders[secret.FolderID] {
+			continue
+		}
+		if !strings.Contains(secret.Name, query.Get("filter.searchText")) {
+			continue
+		}
+		matches = append(matches, serverSecretSummary{ID: secret.ID, Name: secret.Name, FolderID: secret.FolderID})
+	}
+
+	skip, _ := strconv.Atoi(query.Get("skip"))
+	result := secretServerSearchResult{}
+	if skip < len(matches) {
+		result.Records = matches[skip : skip+1]
+		result.HasNext = skip+1 < len(matches)
+		result.NextSkip = skip + 1
+	}
+	writeJSON(w, result)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestSecretServerClient(t *testing.T, serverURL string) esv1beta1.SecretsClient {
+	t.Helper()
+	store := &esv1beta1.SecretStore{
+		TypeMeta: metav1.TypeMeta{Kind: esv1beta1.SecretStoreKind},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				Delinea: &esv1beta1.DelineaProvider{
+					SecretServer: &esv1beta1.DelineaSecretServer{
+						ServerURL: serverURL,
+						Username:  makeSecretRefUsingValue(fakeUsername),
+						Password:  makeSecretRefUsingValue(fakePassword),
+					},
+				},
+			},
+		},
+	}
+	c, err := (&Provider{}).NewClient(context.Background(), store, nil, "default")
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestSecretServerGetSecret(t *testing.T) {
+	f, server := newFakeSecretServer(t)
+	c := newTestSecretServerClient(t, server.URL+"/SecretServer/")
+
+	testCases := map[string]struct {
+		ref  esv1beta1.ExternalSecretDataRemoteRef
+		want string
+		err  error
+	}{
+		"by id returns all fields": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "10"},
+			want: `{"password":"s3cr3t","username":"admin"}`,
+		},
+		"by id and slug": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "10", Property: "password"},
+			want: "s3cr3t",
+		},
+		"by path and field name": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "/Apps/db", Property: "Username"},
+			want: "admin",
+		},
+		"by backslash path": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: `\Other\other-db`, Property: "password"},
+			want: "other",
+		},
+		"file field": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "11", Property: "certificate"},
+			want: "-----BEGIN CERTIFICATE-----",
+		},
+		"missing field": {
+			ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "10", Property: "missing"},
+			err: esv1beta1.NoSecretErr,
+		},
+		"missing secret": {
+			ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "/Apps/missing"},
+			err: esv1beta1.NoSecretErr,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, string(got))
+			} else {
+				assert.Nil(t, got)
+				assert.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	// the access token is reused between requests
+	assert.Equal(t, 1, f.logins)
+}
+
+func TestSecretServerGetSecretMap(t *testing.T) {
+	_, server := newFakeSecretServer(t)
+	c := newTestSecretServerClient(t, server.URL+"/SecretServer")
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "/Apps/Web/web-cert"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"certificate": []byte("-----BEGIN CERTIFICATE-----"),
+		"notes":       []byte("web"),
+	}, got)
+}
+
+func TestSecretServerGetAllSecrets(t *testing.T) {
+	_, server := newFakeSecretServer(t)
+	c := newTestSecretServerClient(t, server.URL+"/SecretServer")
+
+	testCases := map[string]struct {
+		ref  esv1beta1.ExternalSecretFind
+		want []string
+		err  string
+	}{
+		"all secrets": {
+			ref:  esv1beta1.ExternalSecretFind{},
+			want: []string{"db", "other-db", "web-cert"},
+		},
+		"by folder path including sub folders": {
+			ref:  esv1beta1.ExternalSecretFind{Path: utils.Ptr("/Apps")},
+			want: []string{"db", "web-cert"},
+		},
+		"by folder id": {
+			ref:  esv1beta1.ExternalSecretFind{Path: utils.Ptr("3")},
+			want: []string{"other-db"},
+		},
+		"by search text": {
+			ref:  esv1beta1.ExternalSecretFind{Name: &esv1beta1.FindName{RegExp: "db"}},
+			want: []string{"db", "other-db"},
+		},
+		"by regular expression in folder": {
+			ref: esv1beta1.ExternalSecretFind{
+				Path: utils.Ptr("/Apps"),
+				Name: &esv1beta1.FindName{RegExp: "^d.*"},
+			},
+			want: []string{"db"},
+		},
+		"unknown folder": {
+			ref: esv1beta1.ExternalSecretFind{Path: utils.Ptr("/Missing")},
+			err: esv1beta1.NoSecretErr.Error(),
+		},
+		"tags are not supported": {
+			ref: esv1beta1.ExternalSecretFind{Tags: map[string]string{"foo": "bar"}},
+			err: "find by tags is not supported by Delinea Secret Server",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetAllSecrets(context.Background(), tc.ref)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			keys := make([]string, 0, len(got))
+			for k := range got {
+				keys = append(keys, k)
+			}
+			assert.ElementsMatch(t, tc.want, keys)
+		})
+	}
+
+	got, err := c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{Path: utils.Ptr("3")})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"password":"other"}`, string(got["other-db"]))
+}
+
+func TestSecretServerValidate(t *testing.T) {
+	_, server := newFakeSecretServer(t)
+
+	c := newTestSecretServerClient(t, server.URL+"/SecretServer")
+	result, err := c.Validate()
+	assert.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, result)
+
+	api, err := newSecretServer(server.URL+"/SecretServer", fakeUsername, "wrong", "")
+	require.NoError(t, err)
+	c = &secretServerClient{api: api}
+	result, err = c.Validate()
+	assert.ErrorContains(t, err, "invalid_grant")
+	assert.Equal(t, esv1beta1.ValidationResultError, result)
+}
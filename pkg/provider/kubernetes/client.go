@@ -269,16 +269,67 @@ func getSecretMetadata(secret *v1.Secret) (map[string][]byte, error) {
 }
 
 func (c *Client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
-	if ref.Tags != nil {
-		return c.findByTags(ctx, ref)
+	data, _, err := c.GetAllSecretsWithMetadata(ctx, ref)
+	return data, err
+}
+
+// GetSecretMetadata returns the name, labels, resourceVersion and creationTimestamp of the secret.
+func (c *Client) GetSecretMetadata(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (esv1beta1.SecretMetadata, error) {
+	secret, err := c.userSecretClient.Get(ctx, ref.Key, metav1.GetOptions{})
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesGetSecret, err)
+	if apierrors.IsNotFound(err) {
+		return esv1beta1.SecretMetadata{}, esv1beta1.NoSecretError{}
+	}
+	if err != nil {
+		return esv1beta1.SecretMetadata{}, err
+	}
+	return secretMetadata(secret), nil
+}
+
+func secretMetadata(secret *v1.Secret) esv1beta1.SecretMetadata {
+	return esv1beta1.SecretMetadata{
+		Path:        secret.Name,
+		Tags:        secret.Labels,
+		Version:     secret.ResourceVersion,
+		CreatedTime: secret.CreationTimestamp.Time,
+	}
+}
+
+// GetAllSecretsWithMetadata finds secrets like GetAllSecrets and returns the name,
+// labels and resourceVersion of each secret as metadata.
+func (c *Client) GetAllSecretsWithMetadata(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, map[string]esv1beta1.SecretMetadata, error) {
+	var secrets []v1.Secret
+	var err error
+	switch {
+	case ref.Tags != nil:
+		secrets, err = c.findByTags(ctx, ref)
+	case ref.Name != nil:
+		secrets, err = c.findByName(ctx, ref)
+	default:
+		return nil, nil, fmt.Errorf("unexpected find operator: %#v", ref)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	data := make(map[string][]byte)
+	metadata := make(map[string]esv1beta1.SecretMetadata)
+	for i := range secrets {
+		secret := &secrets[i]
+		jsonStr, err := utils.JSONMarshal(convertMap(secret.Data))
+		if err != nil {
+			return nil, nil, err
+		}
+		data[secret.Name] = jsonStr
+		metadata[secret.Name] = secretMetadata(secret)
 	}
-	if ref.Name != nil {
-		return c.findByName(ctx, ref)
+	data, err = utils.ConvertKeys(ref.ConversionStrategy, data)
+	if err != nil {
+		return nil, nil, err
 	}
-	return nil, fmt.Errorf("unexpected find operator: %#v", ref)
+	return data, utils.ConvertMetadataKeys(ref.ConversionStrategy, metadata), nil
 }
 
-func (c *Client) findByTags(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+func (c *Client) findByTags(ctx context.Context, ref esv1beta1.ExternalSecretFind) ([]v1.Secret, error) {
 	// empty/nil tags = everything
 	sel, err := labels.ValidatedSelectorFromSet(ref.Tags)
 	if err != nil {
@@ -289,18 +340,10 @@ func (c *Client) findByTags(ctx context.Context, ref esv1beta1.ExternalSecretFin
 	if err != nil {
 		return nil, fmt.Errorf("unable to list secrets: %w", err)
 	}
-	data := make(map[string][]byte)
-	for _, secret := range secrets.Items {
-		jsonStr, err := utils.JSONMarshal(convertMap(secret.Data))
-		if err != nil {
-			return nil, err
-		}
-		data[secret.Name] = jsonStr
-	}
-	return utils.ConvertKeys(ref.ConversionStrategy, data)
+	return secrets.Items, nil
 }
 
-func (c *Client) findByName(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+func (c *Client) findByName(ctx context.Context, ref esv1beta1.ExternalSecretFind) ([]v1.Secret, error) {
 	secrets, err := c.userSecretClient.List(ctx, metav1.ListOptions{})
 	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesListSecrets, err)
 	if err != nil {
@@ -310,18 +353,13 @@ func (c *Client) findByName(ctx context.Context, ref esv1beta1.ExternalSecretFin
 	if err != nil {
 		return nil, err
 	}
-	data := make(map[string][]byte)
+	matched := make([]v1.Secret, 0, len(secrets.Items))
 	for _, secret := range secrets.Items {
-		if !matcher.MatchName(secret.Name) {
-			continue
+		if matcher.MatchName(secret.Name) {
+			matched = append(matched, secret)
 		}
-		jsonStr, err := utils.JSONMarshal(convertMap(secret.Data))
-		if err != nil {
-			return nil, err
-		}
-		data[secret.Name] = jsonStr
 	}
-	return utils.ConvertKeys(ref.ConversionStrategy, data)
+	return matched, nil
 }
 
 func (c *Client) Close(_ context.Context) error {
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -555,6 +556,89 @@ func TestGetAllSecrets(t *testing.T) {
 	}
 }
 
+func TestGetAllSecretsWithMetadata(t *testing.T) {
+	p := &Client{
+		userSecretClient: &fakeClient{
+			t: t,
+			expectedListOptions: metav1.ListOptions{
+				LabelSelector: "app=foobar",
+			},
+			secretMap: map[string]*v1.Secret{
+				"mysec": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "mysec",
+						Labels:          map[string]string{"app": "foobar"},
+						ResourceVersion: "42",
+					},
+					Data: map[string][]byte{
+						"token": []byte(`foo`),
+					},
+				},
+			},
+		},
+	}
+	got, metadata, err := p.GetAllSecretsWithMetadata(context.Background(), esv1beta1.ExternalSecretFind{
+		Tags: map[string]string{
+			"app": "foobar",
+		},
+	})
+	if err != nil {
+		t.Fatalf("ProviderKubernetes.GetAllSecretsWithMetadata() error = %v", err)
+	}
+	want := map[string][]byte{
+		"mysec": []byte(`{"token":"foo"}`),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProviderKubernetes.GetAllSecretsWithMetadata() = %v, want %v", got, want)
+	}
+	wantMetadata := map[string]esv1beta1.SecretMetadata{
+		"mysec": {
+			Path:    "mysec",
+			Tags:    map[string]string{"app": "foobar"},
+			Version: "42",
+		},
+	}
+	if !reflect.DeepEqual(metadata, wantMetadata) {
+		t.Errorf("ProviderKubernetes.GetAllSecretsWithMetadata() metadata = %v, want %v", metadata, wantMetadata)
+	}
+}
+
+func TestGetSecretMetadata(t *testing.T) {
+	created := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	p := &Client{
+		userSecretClient: &fakeClient{
+			t: t,
+			secretMap: map[string]*v1.Secret{
+				"mysec": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "mysec",
+						Labels:            map[string]string{"app": "foobar"},
+						ResourceVersion:   "42",
+						CreationTimestamp: created,
+					},
+				},
+			},
+		},
+	}
+	got, err := p.GetSecretMetadata(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "mysec"})
+	if err != nil {
+		t.Fatalf("ProviderKubernetes.GetSecretMetadata() error = %v", err)
+	}
+	want := esv1beta1.SecretMetadata{
+		Path:        "mysec",
+		Tags:        map[string]string{"app": "foobar"},
+		Version:     "42",
+		CreatedTime: created.Time,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProviderKubernetes.GetSecretMetadata() = %v, want %v", got, want)
+	}
+	_, err = p.GetSecretMetadata(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"})
+	if !errors.Is(err, esv1beta1.NoSecretError{}) {
+		t.Errorf("ProviderKubernetes.GetSecretMetadata() error = %v, want %v", err, esv1beta1.NoSecretError{})
+	}
+}
+
 func TestDeleteSecret(t *testing.T) {
 	type fields struct {
 		Client KClient
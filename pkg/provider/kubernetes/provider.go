@@ -28,6 +28,7 @@ import (
 	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -123,18 +124,44 @@ func (p *Provider) newClient(ctx context.Context, store esv1beta1.GenericStore,
 		return client, nil
 	}
 
-	if err := client.setAuth(ctx); err != nil {
+	userClientset, err := client.newUserClientset(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client.userSecretClient = userClientset.CoreV1().Secrets(client.store.RemoteNamespace)
+	client.userReviewClient = userClientset.AuthorizationV1().SelfSubjectRulesReviews()
+	return client, nil
+}
+
+// NewGeneratorClient returns a clientset for the Kubernetes server
+// described by the provider spec. It is used by generators which need to
+// talk to a remote cluster, secret references are resolved in the given namespace.
+func (p *Provider) NewGeneratorClient(ctx context.Context, ctrlClient kclient.Client, ctrlClientset kubernetes.Interface, spec *esv1beta1.KubernetesProvider, namespace string) (kubernetes.Interface, error) {
+	client := &Client{
+		ctrlClientset: ctrlClientset.CoreV1(),
+		ctrlClient:    ctrlClient,
+		store:         spec,
+		namespace:     namespace,
+		storeKind:     resolvers.GeneratorStoreKind(ctx),
+	}
+	return client.newUserClientset(ctx)
+}
+
+// newUserClientset authenticates against the configured server
+// and returns a clientset with the user-defined scope.
+func (c *Client) newUserClientset(ctx context.Context) (kubernetes.Interface, error) {
+	if err := c.setAuth(ctx); err != nil {
 		return nil, err
 	}
 
 	config := &rest.Config{
-		Host:        client.store.Server.URL,
-		BearerToken: string(client.BearerToken),
+		Host:        c.store.Server.URL,
+		BearerToken: string(c.BearerToken),
 		TLSClientConfig: rest.TLSClientConfig{
 			Insecure: false,
-			CertData: client.Certificate,
-			KeyData:  client.Key,
-			CAData:   client.CA,
+			CertData: c.Certificate,
+			KeyData:  c.Key,
+			CAData:   c.CA,
 		},
 	}
 
@@ -142,9 +169,7 @@ func (p *Provider) newClient(ctx context.Context, store esv1beta1.GenericStore,
 	if err != nil {
 		return nil, fmt.Errorf("error configuring clientset: %w", err)
 	}
-	client.userSecretClient = userClientset.CoreV1().Secrets(client.store.RemoteNamespace)
-	client.userReviewClient = userClientset.AuthorizationV1().SelfSubjectRulesReviews()
-	return client, nil
+	return userClientset, nil
 }
 
 func isReferentSpec(prov *esv1beta1.KubernetesProvider) bool {
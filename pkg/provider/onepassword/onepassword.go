@@ -43,10 +43,15 @@ const (
 	errOnePasswordStoreNilSpecProviderOnePassword = "nil spec.provider.onepassword"
 	errOnePasswordStoreMissingRefName             = "missing: spec.provider.onepassword.auth.secretRef.connectTokenSecretRef.name"
 	errOnePasswordStoreMissingRefKey              = "missing: spec.provider.onepassword.auth.secretRef.connectTokenSecretRef.key"
+	errOnePasswordStoreMissingSARefName           = "missing: spec.provider.onepassword.auth.secretRef.serviceAccountSecretRef.name"
+	errOnePasswordStoreMissingSARefKey            = "missing: spec.provider.onepassword.auth.secretRef.serviceAccountSecretRef.key"
+	errOnePasswordStoreMultipleAuth               = "only one of connectTokenSecretRef or serviceAccountSecretRef may be set: spec.provider.onepassword.auth.secretRef"
+	errOnePasswordStoreMissingConnectHost         = "missing: spec.provider.onepassword.connectHost"
 	errOnePasswordStoreAtLeastOneVault            = "must be at least one vault: spec.provider.onepassword.vaults"
 	errOnePasswordStoreInvalidConnectHost         = "unable to parse URL: spec.provider.onepassword.connectHost: %w"
 	errOnePasswordStoreNonUniqueVaultNumbers      = "vault order numbers must be unique"
 	errGetVault                                   = "error finding 1Password Vault: %w"
+	errNewSDKClient                               = "error creating 1Password service account client: %w"
 
 	errGetItem               = "error finding 1Password Item: %w"
 	errUpdateItem            = "error updating 1Password Item: %w"
@@ -77,10 +82,23 @@ var (
 	ErrExpectedOneItem = errors.New(errExpectedOneItemMsg)
 )
 
+// onePasswordClient is the subset of connect.Client used by the provider.
+// It is implemented by the Connect client and by sdkClient for service accounts.
+type onePasswordClient interface {
+	GetVaultByTitle(title string) (*onepassword.Vault, error)
+	GetItems(vaultQuery string) ([]onepassword.Item, error)
+	GetItemByUUID(uuid string, vaultQuery string) (*onepassword.Item, error)
+	GetItemsByTitle(title string, vaultQuery string) ([]onepassword.Item, error)
+	CreateItem(item *onepassword.Item, vaultQuery string) (*onepassword.Item, error)
+	UpdateItem(item *onepassword.Item, vaultQuery string) (*onepassword.Item, error)
+	DeleteItem(item *onepassword.Item, vaultQuery string) error
+	GetFileContent(file *onepassword.File) ([]byte, error)
+}
+
 // ProviderOnePassword is a provider for 1Password.
 type ProviderOnePassword struct {
 	vaults map[string]int
-	client connect.Client
+	client onePasswordClient
 }
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -97,6 +115,20 @@ func (provider *ProviderOnePassword) Capabilities() esv1beta1.SecretStoreCapabil
 // NewClient constructs a 1Password Provider.
 func (provider *ProviderOnePassword) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
 	config := store.GetSpec().Provider.OnePassword
+
+	// service accounts talk to the 1Password API directly, without a Connect Server
+	if saRef := config.Auth.SecretRef.ServiceAccountToken; saRef != nil {
+		token, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, saRef)
+		if err != nil {
+			return nil, err
+		}
+		client, err := newSDKClient(ctx, store, namespace, token)
+		if err != nil {
+			return nil, fmt.Errorf(errNewSDKClient, err)
+		}
+		return &ProviderOnePassword{vaults: config.Vaults, client: client}, nil
+	}
+
 	token, err := resolvers.SecretKeyRef(
 		ctx,
 		kube,
@@ -107,9 +139,10 @@ func (provider *ProviderOnePassword) NewClient(ctx context.Context, store esv1be
 	if err != nil {
 		return nil, err
 	}
-	provider.client = connect.NewClientWithUserAgent(config.ConnectHost, token, userAgent)
-	provider.vaults = config.Vaults
-	return provider, nil
+	return &ProviderOnePassword{
+		vaults: config.Vaults,
+		client: connect.NewClientWithUserAgent(config.ConnectHost, token, userAgent),
+	}, nil
 }
 
 // ValidateStore checks if the provided store is valid.
@@ -132,15 +165,7 @@ func validateStore(store esv1beta1.GenericStore) error {
 
 	// check mandatory fields
 	config := storeSpec.Provider.OnePassword
-	if config.Auth.SecretRef.ConnectToken.Name == "" {
-		return fmt.Errorf(errOnePasswordStore, fmt.Errorf(errOnePasswordStoreMissingRefName))
-	}
-	if config.Auth.SecretRef.ConnectToken.Key == "" {
-		return fmt.Errorf(errOnePasswordStore, fmt.Errorf(errOnePasswordStoreMissingRefKey))
-	}
-
-	// check namespace compared to kind
-	if err := utils.ValidateSecretSelector(store, config.Auth.SecretRef.ConnectToken); err != nil {
+	if err := validateAuthSecretRef(store, config.Auth.SecretRef); err != nil {
 		return fmt.Errorf(errOnePasswordStore, err)
 	}
 
@@ -154,7 +179,15 @@ func validateStore(store esv1beta1.GenericStore) error {
 		return fmt.Errorf(errOnePasswordStore, fmt.Errorf(errOnePasswordStoreNonUniqueVaultNumbers))
 	}
 
+	// service accounts do not use a Connect Server
+	if config.Auth.SecretRef.ServiceAccountToken != nil {
+		return nil
+	}
+
 	// check valid URL
+	if config.ConnectHost == "" {
+		return fmt.Errorf(errOnePasswordStore, fmt.Errorf(errOnePasswordStoreMissingConnectHost))
+	}
 	if _, err := url.Parse(config.ConnectHost); err != nil {
 		return fmt.Errorf(errOnePasswordStore, fmt.Errorf(errOnePasswordStoreInvalidConnectHost, err))
 	}
@@ -162,6 +195,35 @@ func validateStore(store esv1beta1.GenericStore) error {
 	return nil
 }
 
+// validateAuthSecretRef checks that exactly one of the Connect token or the
+// service account token is referenced and that the reference is complete.
+func validateAuthSecretRef(store esv1beta1.GenericStore, secretRef *esv1beta1.OnePasswordAuthSecretRef) error {
+	if secretRef.ServiceAccountToken == nil {
+		if secretRef.ConnectToken.Name == "" {
+			return fmt.Errorf(errOnePasswordStoreMissingRefName)
+		}
+		if secretRef.ConnectToken.Key == "" {
+			return fmt.Errorf(errOnePasswordStoreMissingRefKey)
+		}
+
+		// check namespace compared to kind
+		return utils.ValidateSecretSelector(store, secretRef.ConnectToken)
+	}
+
+	if secretRef.ConnectToken.Name != "" || secretRef.ConnectToken.Key != "" {
+		return fmt.Errorf(errOnePasswordStoreMultipleAuth)
+	}
+	if secretRef.ServiceAccountToken.Name == "" {
+		return fmt.Errorf(errOnePasswordStoreMissingSARefName)
+	}
+	if secretRef.ServiceAccountToken.Key == "" {
+		return fmt.Errorf(errOnePasswordStoreMissingSARefKey)
+	}
+
+	// check namespace compared to kind
+	return utils.ValidateSecretSelector(store, *secretRef.ServiceAccountToken)
+}
+
 func deleteField(fields []*onepassword.ItemField, label string) ([]*onepassword.ItemField, error) {
 	// This will always iterate over all items
 	// but its done to ensure that two fields with the same label
@@ -342,6 +404,9 @@ func (provider *ProviderOnePassword) GetSecret(_ context.Context, ref esv1beta1.
 
 	// handle files
 	if item.Category == documentCategory {
+		if err := provider.checkFilesSupported(item); err != nil {
+			return nil, err
+		}
 		// default to the first file when ref.Property is empty
 		return provider.getFile(item, ref.Property)
 	}
@@ -376,6 +441,9 @@ func (provider *ProviderOnePassword) GetSecretMap(_ context.Context, ref esv1bet
 
 	// handle files
 	if item.Category == documentCategory {
+		if err := provider.checkFilesSupported(item); err != nil {
+			return nil, err
+		}
 		return provider.getFiles(item, ref.Property)
 	}
 
@@ -502,6 +570,15 @@ func (provider *ProviderOnePassword) getAllFields(item onepassword.Item, ref esv
 	return nil
 }
 
+// checkFilesSupported rejects Document items when the client can not read files,
+// instead of reporting their files as missing.
+func (provider *ProviderOnePassword) checkFilesSupported(item *onepassword.Item) error {
+	if _, ok := provider.client.(*sdkClient); ok {
+		return fmt.Errorf(errSDKFilesNotSupported, item.Title)
+	}
+	return nil
+}
+
 func (provider *ProviderOnePassword) getFile(item *onepassword.Item, property string) ([]byte, error) {
 	for _, file := range item.Files {
 		// default to the first file when ref.Property is empty
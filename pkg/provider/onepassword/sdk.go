@@ -0,0 +1,401 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onepassword
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/1Password/connect-sdk-go/onepassword"
+	onepasswordsdk "github.com/1password/onepassword-sdk-go"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/cache"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errSDKVaultTitle        = "found %d vaults with title %q"
+	errSDKVaultQuery        = "found %d vaults matching %q"
+	errSDKFilesNotSupported = "cannot read files of %q: files are not supported when authenticating with a 1Password service account"
+
+	sdkClientCacheSize = 1024
+)
+
+var (
+	// sdkClientCache keeps the SDK clients of the stores, so the SDK
+	// is not loaded and authenticated again on every reconcile.
+	sdkClientCache = cache.Must[*onepasswordsdk.Client](sdkClientCacheSize, nil)
+
+	// sdkCategories maps the 1Password SDK item categories to their Connect equivalent.
+	sdkCategories = map[onepasswordsdk.ItemCategory]onepassword.ItemCategory{
+		onepasswordsdk.ItemCategoryLogin:                onepassword.Login,
+		onepasswordsdk.ItemCategoryPassword:             onepassword.Password,
+		onepasswordsdk.ItemCategoryAPICredentials:       onepassword.ApiCredential,
+		onepasswordsdk.ItemCategoryServer:               onepassword.Server,
+		onepasswordsdk.ItemCategoryDatabase:             onepassword.Database,
+		onepasswordsdk.ItemCategoryCreditCard:           onepassword.CreditCard,
+		onepasswordsdk.ItemCategoryMembership:           onepassword.Membership,
+		onepasswordsdk.ItemCategoryPassport:             onepassword.Passport,
+		onepasswordsdk.ItemCategorySoftwareLicense:      onepassword.SoftwareLicense,
+		onepasswordsdk.ItemCategoryOutdoorLicense:       onepassword.OutdoorLicense,
+		onepasswordsdk.ItemCategorySecureNote:           onepassword.SecureNote,
+		onepasswordsdk.ItemCategoryRouter:               onepassword.WirelessRouter,
+		onepasswordsdk.ItemCategoryBankAccount:          onepassword.BankAccount,
+		onepasswordsdk.ItemCategoryDriverLicense:        onepassword.DriverLicense,
+		onepasswordsdk.ItemCategoryIdentity:             onepassword.Identity,
+		onepasswordsdk.ItemCategoryRewards:              onepassword.RewardProgram,
+		onepasswordsdk.ItemCategoryDocument:             onepassword.Document,
+		onepasswordsdk.ItemCategoryEmail:                onepassword.EmailAccount,
+		onepasswordsdk.ItemCategorySocialSecurityNumber: onepassword.SocialSecurityNumber,
+		onepasswordsdk.ItemCategoryMedicalRecord:        onepassword.MedicalRecord,
+		onepasswordsdk.ItemCategorySSHKey:               onepassword.SSHKey,
+	}
+
+	// sdkFieldTypes maps the 1Password SDK field types to their Connect equivalent.
+	sdkFieldTypes = map[onepasswordsdk.ItemFieldType]onepassword.ItemFieldType{
+		onepasswordsdk.ItemFieldTypeText:           onepassword.FieldTypeString,
+		onepasswordsdk.ItemFieldTypeConcealed:      onepassword.FieldTypeConcealed,
+		onepasswordsdk.ItemFieldTypeCreditCardType: onepassword.FieldTypeCreditCardType,
+		onepasswordsdk.ItemFieldTypePhone:          onepassword.FieldTypePhone,
+		onepasswordsdk.ItemFieldTypeURL:            onepassword.FieldTypeURL,
+		onepasswordsdk.ItemFieldTypeTOTP:           onepassword.FieldTypeOTP,
+	}
+)
+
+// sdkClient implements onePasswordClient on top of the 1Password SDK.
+// It authenticates with a service account token and talks to the 1Password API directly,
+// translating SDK items into Connect items so the provider logic is shared between both modes.
+type sdkClient struct {
+	// the SDK releases its session once the client is garbage collected,
+	// so keep a reference to it rather than only to its APIs.
+	client *onepasswordsdk.Client
+	// onePasswordClient mirrors the Connect client, which does not take a context,
+	// so the context of the reconcile the client is used for is kept instead.
+	ctx context.Context
+}
+
+// newSDKClient returns a client for the store using the cached SDK client
+// if the store and the token have not changed since it was created.
+func newSDKClient(ctx context.Context, store esv1beta1.GenericStore, namespace, token string) (*sdkClient, error) {
+	key := cache.Key{
+		Name:      store.GetName(),
+		Namespace: namespace,
+		Kind:      store.GetKind(),
+	}
+	version := store.GetObjectMeta().ResourceVersion + "/" + utils.ObjectHash(token)
+	if client, ok := sdkClientCache.Get(version, key); ok {
+		return &sdkClient{client: client, ctx: ctx}, nil
+	}
+
+	client, err := onepasswordsdk.NewClient(
+		ctx,
+		onepasswordsdk.WithServiceAccountToken(token),
+		onepasswordsdk.WithIntegrationInfo(userAgent, onepasswordsdk.DefaultIntegrationVersion),
+	)
+	if err != nil {
+		return nil, err
+	}
+	sdkClientCache.Add(version, key, client)
+
+	return &sdkClient{client: client, ctx: ctx}, nil
+}
+
+// GetVaultByTitle returns the only vault with the given title.
+func (c *sdkClient) GetVaultByTitle(title string) (*onepassword.Vault, error) {
+	vaults, err := c.listVaults()
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]onepassword.Vault, 0, 1)
+	for _, vault := range vaults {
+		if vault.Title == title {
+			found = append(found, onepassword.Vault{ID: vault.ID, Name: vault.Title})
+		}
+	}
+	if len(found) != 1 {
+		return nil, fmt.Errorf(errSDKVaultTitle, len(found), title)
+	}
+
+	return &found[0], nil
+}
+
+// GetItems returns an overview of all items in a vault. Fields are not populated.
+func (c *sdkClient) GetItems(vaultQuery string) ([]onepassword.Item, error) {
+	iter, err := c.client.Items.ListAll(c.ctx, vaultQuery)
+	if err != nil {
+		return nil, err
+	}
+	overviews, err := collect(iter)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]onepassword.Item, 0, len(overviews))
+	for _, overview := range overviews {
+		items = append(items, onepassword.Item{
+			ID:       overview.ID,
+			Title:    overview.Title,
+			Category: toConnectCategory(overview.Category),
+			Vault:    onepassword.ItemVault{ID: overview.VaultID},
+		})
+	}
+
+	return items, nil
+}
+
+// GetItemsByTitle returns an overview of the items in a vault with the given title.
+func (c *sdkClient) GetItemsByTitle(title, vaultQuery string) ([]onepassword.Item, error) {
+	items, err := c.GetItems(vaultQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]onepassword.Item, 0, 1)
+	for _, item := range items {
+		if item.Title == title {
+			found = append(found, item)
+		}
+	}
+
+	return found, nil
+}
+
+// GetItemByUUID returns a fully populated item.
+func (c *sdkClient) GetItemByUUID(uuid, vaultQuery string) (*onepassword.Item, error) {
+	item, err := c.client.Items.Get(c.ctx, vaultQuery, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromSDKItem(item), nil
+}
+
+// CreateItem creates an item in the vault identified by its id or title.
+func (c *sdkClient) CreateItem(item *onepassword.Item, vaultQuery string) (*onepassword.Item, error) {
+	vaultID, err := c.resolveVaultID(vaultQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := c.client.Items.Create(c.ctx, onepasswordsdk.ItemCreateParams{
+		Category: toSDKCategory(item.Category),
+		VaultID:  vaultID,
+		Title:    item.Title,
+		Fields:   toSDKFields(item.Fields, nil),
+		Sections: toSDKSections(item.Sections),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromSDKItem(created), nil
+}
+
+// UpdateItem replaces the title, fields and sections of an existing item.
+// Fields that already exist keep their SDK specific attributes.
+func (c *sdkClient) UpdateItem(item *onepassword.Item, vaultQuery string) (*onepassword.Item, error) {
+	current, err := c.client.Items.Get(c.ctx, vaultQuery, item.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	current.Title = item.Title
+	current.Fields = toSDKFields(item.Fields, current.Fields)
+	current.Sections = toSDKSections(item.Sections)
+	updated, err := c.client.Items.Put(c.ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromSDKItem(updated), nil
+}
+
+// DeleteItem deletes an item.
+func (c *sdkClient) DeleteItem(item *onepassword.Item, vaultQuery string) error {
+	return c.client.Items.Delete(c.ctx, vaultQuery, item.ID)
+}
+
+// GetFileContent is not supported, the 1Password SDK does not give access to files yet.
+func (c *sdkClient) GetFileContent(file *onepassword.File) ([]byte, error) {
+	return nil, fmt.Errorf(errSDKFilesNotSupported, file.Name)
+}
+
+func (c *sdkClient) listVaults() ([]onepasswordsdk.VaultOverview, error) {
+	iter, err := c.client.Vaults.ListAll(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(iter)
+}
+
+// resolveVaultID accepts either a vault id or a vault title.
+func (c *sdkClient) resolveVaultID(vaultQuery string) (string, error) {
+	vaults, err := c.listVaults()
+	if err != nil {
+		return "", err
+	}
+
+	found := make([]string, 0, 1)
+	for _, vault := range vaults {
+		if vault.ID == vaultQuery {
+			return vault.ID, nil
+		}
+		if vault.Title == vaultQuery {
+			found = append(found, vault.ID)
+		}
+	}
+	if len(found) != 1 {
+		return "", fmt.Errorf(errSDKVaultQuery, len(found), vaultQuery)
+	}
+
+	return found[0], nil
+}
+
+func collect[T any](iter *onepasswordsdk.Iterator[T]) ([]T, error) {
+	var values []T
+	for {
+		value, err := iter.Next()
+		if errors.Is(err, onepasswordsdk.ErrorIteratorDone) {
+			return values, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, *value)
+	}
+}
+
+func fromSDKItem(item onepasswordsdk.Item) *onepassword.Item {
+	sections := make(map[string]*onepassword.ItemSection, len(item.Sections))
+	converted := &onepassword.Item{
+		ID:       item.ID,
+		Title:    item.Title,
+		Category: toConnectCategory(item.Category),
+		Version:  int(item.Version),
+		Vault:    onepassword.ItemVault{ID: item.VaultID},
+		Sections: make([]*onepassword.ItemSection, 0, len(item.Sections)),
+		Fields:   make([]*onepassword.ItemField, 0, len(item.Fields)),
+	}
+	for _, section := range item.Sections {
+		s := &onepassword.ItemSection{ID: section.ID, Label: section.Title}
+		sections[section.ID] = s
+		converted.Sections = append(converted.Sections, s)
+	}
+	for _, field := range item.Fields {
+		f := &onepassword.ItemField{
+			ID:    field.ID,
+			Label: field.Title,
+			Type:  toConnectFieldType(field.FieldType),
+			Value: field.Value,
+		}
+		if field.SectionID != nil {
+			f.Section = sections[*field.SectionID]
+		}
+		if field.Details != nil && field.Details.OTP() != nil && field.Details.OTP().Code != nil {
+			f.TOTP = *field.Details.OTP().Code
+		}
+		converted.Fields = append(converted.Fields, f)
+	}
+
+	return converted
+}
+
+// toSDKFields converts Connect fields to SDK fields, reusing the matching field of
+// existing (by id) so that attributes without a Connect equivalent are preserved.
+func toSDKFields(fields []*onepassword.ItemField, existing []onepasswordsdk.ItemField) []onepasswordsdk.ItemField {
+	existingByID := make(map[string]onepasswordsdk.ItemField, len(existing))
+	for _, field := range existing {
+		existingByID[field.ID] = field
+	}
+
+	converted := make([]onepasswordsdk.ItemField, 0, len(fields))
+	for _, field := range fields {
+		if current, ok := existingByID[field.ID]; ok && field.ID != "" {
+			current.Title = field.Label
+			current.Value = field.Value
+			converted = append(converted, current)
+			continue
+		}
+
+		// new fields created by the provider have no id yet
+		id := field.ID
+		if id == "" {
+			id = field.Label
+		}
+		f := onepasswordsdk.ItemField{
+			ID:        id,
+			Title:     field.Label,
+			FieldType: toSDKFieldType(field.Type),
+			Value:     field.Value,
+		}
+		if field.Section != nil {
+			sectionID := field.Section.ID
+			f.SectionID = &sectionID
+		}
+		converted = append(converted, f)
+	}
+
+	return converted
+}
+
+func toSDKSections(sections []*onepassword.ItemSection) []onepasswordsdk.ItemSection {
+	converted := make([]onepasswordsdk.ItemSection, 0, len(sections))
+	for _, section := range sections {
+		converted = append(converted, onepasswordsdk.ItemSection{ID: section.ID, Title: section.Label})
+	}
+
+	return converted
+}
+
+func toConnectCategory(category onepasswordsdk.ItemCategory) onepassword.ItemCategory {
+	if c, ok := sdkCategories[category]; ok {
+		return c
+	}
+
+	return onepassword.Custom
+}
+
+func toSDKCategory(category onepassword.ItemCategory) onepasswordsdk.ItemCategory {
+	for sdkCategory, connectCategory := range sdkCategories {
+		if connectCategory == category {
+			return sdkCategory
+		}
+	}
+
+	return onepasswordsdk.ItemCategoryUnsupported
+}
+
+func toConnectFieldType(fieldType onepasswordsdk.ItemFieldType) onepassword.ItemFieldType {
+	if t, ok := sdkFieldTypes[fieldType]; ok {
+		return t
+	}
+
+	return onepassword.FieldTypeUnknown
+}
+
+func toSDKFieldType(fieldType onepassword.ItemFieldType) onepasswordsdk.ItemFieldType {
+	for sdkFieldType, connectFieldType := range sdkFieldTypes {
+		if connectFieldType == fieldType {
+			return sdkFieldType
+		}
+	}
+
+	// the provider stores pushed values as concealed fields
+	return onepasswordsdk.ItemFieldTypeConcealed
+}
@@ -0,0 +1,349 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onepassword
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/1Password/connect-sdk-go/onepassword"
+	onepasswordsdk "github.com/1password/onepassword-sdk-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/cache"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+// fakeSDK implements the vaults and items APIs of the 1Password SDK in memory.
+type fakeSDK struct {
+	vaults []onepasswordsdk.VaultOverview
+	items  map[string]onepasswordsdk.Item
+	nextID int
+}
+
+func newFakeSDK(vaults ...onepasswordsdk.VaultOverview) *fakeSDK {
+	return &fakeSDK{
+		vaults: vaults,
+		items:  map[string]onepasswordsdk.Item{},
+	}
+}
+
+func (f *fakeSDK) add(item onepasswordsdk.Item) {
+	f.items[item.VaultID+"/"+item.ID] = item
+}
+
+func (f *fakeSDK) ListAll(_ context.Context) (*onepasswordsdk.Iterator[onepasswordsdk.VaultOverview], error) {
+	return onepasswordsdk.NewIterator(f.vaults), nil
+}
+
+func (f *fakeSDK) Create(_ context.Context, params onepasswordsdk.ItemCreateParams) (onepasswordsdk.Item, error) {
+	f.nextID++
+	item := onepasswordsdk.Item{
+		ID:       fmt.Sprintf("created-%d", f.nextID),
+		Title:    params.Title,
+		Category: params.Category,
+		VaultID:  params.VaultID,
+		Fields:   params.Fields,
+		Sections: params.Sections,
+	}
+	f.add(item)
+	return item, nil
+}
+
+func (f *fakeSDK) Get(_ context.Context, vaultID, itemID string) (onepasswordsdk.Item, error) {
+	item, ok := f.items[vaultID+"/"+itemID]
+	if !ok {
+		return onepasswordsdk.Item{}, errors.New("item not found")
+	}
+	return item, nil
+}
+
+func (f *fakeSDK) Put(_ context.Context, item onepasswordsdk.Item) (onepasswordsdk.Item, error) {
+	if _, ok := f.items[item.VaultID+"/"+item.ID]; !ok {
+		return onepasswordsdk.Item{}, errors.New("item not found")
+	}
+	item.Version++
+	f.add(item)
+	return item, nil
+}
+
+func (f *fakeSDK) Delete(_ context.Context, vaultID, itemID string) error {
+	delete(f.items, vaultID+"/"+itemID)
+	return nil
+}
+
+func (f *fakeSDK) listItems(vaultID string) []onepasswordsdk.ItemOverview {
+	overviews := []onepasswordsdk.ItemOverview{}
+	for _, item := range f.items {
+		if item.VaultID == vaultID {
+			overviews = append(overviews, onepasswordsdk.ItemOverview{ID: item.ID, Title: item.Title, Category: item.Category, VaultID: item.VaultID})
+		}
+	}
+	return overviews
+}
+
+// fakeSDKItems exposes the items API, whose ListAll signature differs from the vaults API.
+type fakeSDKItems struct {
+	*fakeSDK
+}
+
+func (f fakeSDKItems) ListAll(_ context.Context, vaultID string) (*onepasswordsdk.Iterator[onepasswordsdk.ItemOverview], error) {
+	return onepasswordsdk.NewIterator(f.listItems(vaultID)), nil
+}
+
+func newSDKProvider(f *fakeSDK, vaults map[string]int) *ProviderOnePassword {
+	return &ProviderOnePassword{
+		vaults: vaults,
+		client: &sdkClient{client: &onepasswordsdk.Client{Vaults: f, Items: fakeSDKItems{f}}, ctx: context.Background()},
+	}
+}
+
+func TestSDKGetSecret(t *testing.T) {
+	f := newFakeSDK(
+		onepasswordsdk.VaultOverview{ID: myVaultID, Title: myVault},
+		onepasswordsdk.VaultOverview{ID: myOtherVaultID, Title: myOtherVault},
+	)
+	sectionID := "section"
+	code := "123456"
+	otpDetails := onepasswordsdk.NewItemFieldDetailsTypeVariantOTP(&onepasswordsdk.OTPFieldDetails{Code: &code})
+	f.add(onepasswordsdk.Item{
+		ID:       myItemID,
+		Title:    myItem,
+		Category: onepasswordsdk.ItemCategoryLogin,
+		VaultID:  myVaultID,
+		Sections: []onepasswordsdk.ItemSection{{ID: sectionID, Title: "extra"}},
+		Fields: []onepasswordsdk.ItemField{
+			{ID: password, Title: password, FieldType: onepasswordsdk.ItemFieldTypeConcealed, Value: value1},
+			{ID: key2, Title: key2, FieldType: onepasswordsdk.ItemFieldTypeText, Value: value2, SectionID: &sectionID},
+			{
+				ID:        "otp",
+				Title:     "otp",
+				FieldType: onepasswordsdk.ItemFieldTypeTOTP,
+				Value:     "otpauth://totp/example",
+				Details:   &otpDetails,
+			},
+		},
+	})
+	f.add(onepasswordsdk.Item{
+		ID:       myOtherItemID,
+		Title:    myItem,
+		Category: onepasswordsdk.ItemCategoryLogin,
+		VaultID:  myOtherVaultID,
+		Fields: []onepasswordsdk.ItemField{
+			{ID: password, Title: password, FieldType: onepasswordsdk.ItemFieldTypeConcealed, Value: value3},
+		},
+	})
+
+	// the vault with the lowest number wins
+	provider := newSDKProvider(f, map[string]int{myVault: 1, myOtherVault: 2})
+	got, err := provider.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: myItem})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != value1 {
+		t.Errorf("GetSecret: expected %q, got %q", value1, got)
+	}
+
+	provider = newSDKProvider(f, map[string]int{myVault: 2, myOtherVault: 1})
+	got, err = provider.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: myItem})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != value3 {
+		t.Errorf("GetSecret: expected %q, got %q", value3, got)
+	}
+
+	provider = newSDKProvider(f, map[string]int{myVault: 1})
+	gotMap, err := provider.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: myItem})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedMap := map[string][]byte{
+		password: []byte(value1),
+		key2:     []byte(value2),
+		"otp":    []byte("otpauth://totp/example"),
+	}
+	if !reflect.DeepEqual(gotMap, expectedMap) {
+		t.Errorf("GetSecretMap: expected %v, got %v", expectedMap, gotMap)
+	}
+
+	item, err := provider.findItem(myItem)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Category != onepassword.Login || item.Fields[1].Section == nil || item.Fields[1].Section.Label != "extra" || item.Fields[2].TOTP != code {
+		t.Errorf("findItem: item was not converted as expected: %+v", item)
+	}
+
+	if _, err := provider.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"}); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("GetSecret: expected %v, got %v", ErrKeyNotFound, err)
+	}
+}
+
+func TestNewSDKClientCached(t *testing.T) {
+	store := &esv1beta1.SecretStore{
+		TypeMeta:   metav1.TypeMeta{Kind: esv1beta1.SecretStoreKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "onepassword", Namespace: "default", ResourceVersion: "1"},
+	}
+	cached := &onepasswordsdk.Client{}
+	sdkClientCache.Add("1/"+utils.ObjectHash("token"), cache.Key{Name: "onepassword", Namespace: "default", Kind: esv1beta1.SecretStoreKind}, cached)
+
+	ctx := context.WithValue(context.Background(), testingContextKey{}, "reconcile")
+	client, err := newSDKClient(ctx, store, "default", "token")
+	if err != nil {
+		t.Fatalf("newSDKClient: %v", err)
+	}
+	if client.client != cached {
+		t.Errorf("newSDKClient: expected the cached SDK client to be reused")
+	}
+	if client.ctx != ctx {
+		t.Errorf("newSDKClient: expected the context of the reconcile to be used")
+	}
+}
+
+type testingContextKey struct{}
+
+func TestSDKDocumentsNotSupported(t *testing.T) {
+	f := newFakeSDK(onepasswordsdk.VaultOverview{ID: myVaultID, Title: myVault})
+	f.add(onepasswordsdk.Item{
+		ID:       myItemID,
+		Title:    myItem,
+		Category: onepasswordsdk.ItemCategoryDocument,
+		VaultID:  myVaultID,
+	})
+	provider := newSDKProvider(f, map[string]int{myVault: 1})
+
+	expected := fmt.Sprintf(errSDKFilesNotSupported, myItem)
+	if _, err := provider.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: myItem}); err == nil || err.Error() != expected {
+		t.Errorf("GetSecret: expected %q, got %v", expected, err)
+	}
+	if _, err := provider.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: myItem}); err == nil || err.Error() != expected {
+		t.Errorf("GetSecretMap: expected %q, got %v", expected, err)
+	}
+}
+
+func TestNewClientDoesNotMutateProvider(t *testing.T) {
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "onepassword", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("token")},
+	}).Build()
+	store := &esv1beta1.SecretStore{
+		TypeMeta:   metav1.TypeMeta{Kind: esv1beta1.SecretStoreKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "onepassword", Namespace: "default"},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				OnePassword: &esv1beta1.OnePasswordProvider{
+					ConnectHost: "https://example.com",
+					Vaults:      map[string]int{myVault: 1},
+					Auth: &esv1beta1.OnePasswordAuth{
+						SecretRef: &esv1beta1.OnePasswordAuthSecretRef{
+							ConnectToken: esmeta.SecretKeySelector{Name: "onepassword", Key: "token"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	provider := &ProviderOnePassword{}
+	client, err := provider.NewClient(context.Background(), store, kube, "default")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if client == provider || provider.client != nil || provider.vaults != nil {
+		t.Errorf("NewClient: expected a new client, the provider was modified")
+	}
+	if got := client.(*ProviderOnePassword).vaults; !reflect.DeepEqual(got, map[string]int{myVault: 1}) {
+		t.Errorf("NewClient: expected the vaults of the store, got %v", got)
+	}
+}
+
+func TestSDKValidate(t *testing.T) {
+	f := newFakeSDK(onepasswordsdk.VaultOverview{ID: myVaultID, Title: myVault})
+
+	provider := newSDKProvider(f, map[string]int{myVault: 1})
+	if result, err := provider.Validate(); err != nil || result != esv1beta1.ValidationResultReady {
+		t.Errorf("Validate: expected ready, got %v: %v", result, err)
+	}
+
+	provider = newSDKProvider(f, map[string]int{myVault: 1, myOtherVault: 2})
+	if result, err := provider.Validate(); err == nil || result != esv1beta1.ValidationResultError {
+		t.Errorf("Validate: expected an error for a missing vault, got %v", result)
+	}
+}
+
+func TestSDKPushAndDeleteSecret(t *testing.T) {
+	f := newFakeSDK(onepasswordsdk.VaultOverview{ID: myVaultID, Title: myVault})
+	provider := newSDKProvider(f, map[string]int{myVault: 1})
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			key1: []byte(value1),
+			key2: []byte(value2),
+		},
+	}
+
+	// create a new item in the first vault
+	ref := testingfake.PushSecretData{SecretKey: key1, RemoteKey: myItem, Property: key1}
+	if err := provider.PushSecret(context.Background(), secret, ref); err != nil {
+		t.Fatalf("PushSecret: unexpected error: %v", err)
+	}
+	items := f.listItems(myVaultID)
+	if len(items) != 1 {
+		t.Fatalf("PushSecret: expected one item, got %d", len(items))
+	}
+	created := f.items[myVaultID+"/"+items[0].ID]
+	if created.Category != onepasswordsdk.ItemCategoryServer || len(created.Fields) != 1 ||
+		created.Fields[0].Title != key1 || created.Fields[0].Value != value1 ||
+		created.Fields[0].FieldType != onepasswordsdk.ItemFieldTypeConcealed {
+		t.Errorf("PushSecret: unexpected item %+v", created)
+	}
+
+	// add a second field to the existing item
+	ref = testingfake.PushSecretData{SecretKey: key2, RemoteKey: myItem, Property: key2}
+	if err := provider.PushSecret(context.Background(), secret, ref); err != nil {
+		t.Fatalf("PushSecret: unexpected error: %v", err)
+	}
+	got, err := provider.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: myItem})
+	if err != nil {
+		t.Fatalf("GetSecretMap: unexpected error: %v", err)
+	}
+	expected := map[string][]byte{key1: []byte(value1), key2: []byte(value2)}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("PushSecret: expected %v, got %v", expected, got)
+	}
+
+	// deleting the last field deletes the item
+	if err := provider.DeleteSecret(context.Background(), testingfake.PushSecretData{RemoteKey: myItem, Property: key1}); err != nil {
+		t.Fatalf("DeleteSecret: unexpected error: %v", err)
+	}
+	if len(f.listItems(myVaultID)) != 1 {
+		t.Errorf("DeleteSecret: item should still exist")
+	}
+	if err := provider.DeleteSecret(context.Background(), testingfake.PushSecretData{RemoteKey: myItem, Property: key2}); err != nil {
+		t.Fatalf("DeleteSecret: unexpected error: %v", err)
+	}
+	if len(f.listItems(myVaultID)) != 0 {
+		t.Errorf("DeleteSecret: item should have been deleted")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tidwall/gjson"
 
@@ -185,7 +186,54 @@ func getSecretValue(data map[string]interface{}, property string) ([]byte, error
 }
 
 func (c *client) readSecretMetadata(ctx context.Context, path string) (map[string]string, error) {
-	metadata := make(map[string]string)
+	data, err := c.readMetadata(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return customMetadata(data), nil
+}
+
+// GetSecretMetadata returns the custom_metadata of the secret as tags
+// and the version read with the reference together with its created_time.
+// Metadata is only available with KV v2.
+func (c *client) GetSecretMetadata(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (esv1beta1.SecretMetadata, error) {
+	if c.store.Version == esv1beta1.VaultKVStoreV1 {
+		return esv1beta1.SecretMetadata{}, errors.New(errUnsupportedMetadataKvVersion)
+	}
+	data, err := c.readMetadata(ctx, ref.Key)
+	if err != nil {
+		return esv1beta1.SecretMetadata{}, err
+	}
+	return secretMetadata(ref.Key, ref.Version, data)
+}
+
+// secretMetadata converts the KV v2 metadata of the secret at path.
+// The created_time is the one of the given version, or of the current version if it is empty.
+func secretMetadata(path, version string, data map[string]interface{}) (esv1beta1.SecretMetadata, error) {
+	metadata := esv1beta1.SecretMetadata{
+		Path:    path,
+		Tags:    customMetadata(data),
+		Version: version,
+	}
+	if metadata.Version == "" {
+		if current, ok := data["current_version"]; ok && current != nil {
+			metadata.Version = fmt.Sprint(current)
+		}
+	}
+	versions, _ := data["versions"].(map[string]interface{})
+	versionData, _ := versions[metadata.Version].(map[string]interface{})
+	if created, ok := versionData["created_time"].(string); ok {
+		createdTime, err := time.Parse(time.RFC3339Nano, created)
+		if err != nil {
+			return esv1beta1.SecretMetadata{}, fmt.Errorf(errReadSecret, err)
+		}
+		metadata.CreatedTime = createdTime
+	}
+	return metadata, nil
+}
+
+// readMetadata reads the KV v2 metadata of the secret at the given path.
+func (c *client) readMetadata(ctx context.Context, path string) (map[string]interface{}, error) {
 	url, err := c.buildMetadataPath(path)
 	if err != nil {
 		return nil, err
@@ -198,18 +246,24 @@ func (c *client) readSecretMetadata(ctx context.Context, path string) (map[strin
 	if secret == nil {
 		return nil, errors.New(errNotFound)
 	}
-	t, ok := secret.Data["custom_metadata"]
+	return secret.Data, nil
+}
+
+// customMetadata returns the custom_metadata of the KV v2 metadata.
+func customMetadata(data map[string]interface{}) map[string]string {
+	t, ok := data["custom_metadata"]
 	if !ok {
-		return nil, nil
+		return nil
 	}
+	metadata := make(map[string]string)
 	d, ok := t.(map[string]interface{})
 	if !ok {
-		return metadata, nil
+		return metadata
 	}
 	for k, v := range d {
 		metadata[k] = v.(string)
 	}
-	return metadata, nil
+	return metadata
 }
 
 func (c *client) buildMetadataPath(path string) (string, error) {
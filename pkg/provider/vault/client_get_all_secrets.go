@@ -34,8 +34,19 @@ const (
 // First load all secrets from secretStore path configuration
 // Then, gets secrets from a matching name or matching custom_metadata.
 func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	secrets, _, err := c.getAllSecrets(ctx, ref, false)
+	return secrets, err
+}
+
+// GetAllSecretsWithMetadata gets secrets like GetAllSecrets and returns the path,
+// custom_metadata, current version and its created_time of each secret as metadata.
+func (c *client) GetAllSecretsWithMetadata(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, map[string]esv1beta1.SecretMetadata, error) {
+	return c.getAllSecrets(ctx, ref, true)
+}
+
+func (c *client) getAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind, withMetadata bool) (map[string][]byte, map[string]esv1beta1.SecretMetadata, error) {
 	if c.store.Version == esv1beta1.VaultKVStoreV1 {
-		return nil, errors.New(errUnsupportedKvVersion)
+		return nil, nil, errors.New(errUnsupportedKvVersion)
 	}
 	searchPath := ""
 	if ref.Path != nil {
@@ -43,22 +54,24 @@ func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecret
 	}
 	potentialSecrets, err := c.listSecrets(ctx, searchPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if ref.Name != nil {
-		return c.findSecretsFromName(ctx, potentialSecrets, *ref.Name)
+		return c.findSecretsFromName(ctx, potentialSecrets, *ref.Name, withMetadata)
 	}
 	return c.findSecretsFromTags(ctx, potentialSecrets, ref.Tags)
 }
 
-func (c *client) findSecretsFromTags(ctx context.Context, candidates []string, tags map[string]string) (map[string][]byte, error) {
+func (c *client) findSecretsFromTags(ctx context.Context, candidates []string, tags map[string]string) (map[string][]byte, map[string]esv1beta1.SecretMetadata, error) {
 	secrets := make(map[string][]byte)
+	secretsMetadata := make(map[string]esv1beta1.SecretMetadata)
 	for _, name := range candidates {
 		match := true
-		metadata, err := c.readSecretMetadata(ctx, name)
+		data, err := c.readMetadata(ctx, name)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		metadata := customMetadata(data)
 		for tk, tv := range tags {
 			p, ok := metadata[tk]
 			if !ok || p != tv {
@@ -72,21 +85,26 @@ func (c *client) findSecretsFromTags(ctx context.Context, candidates []string, t
 				continue
 			}
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			if secret != nil {
 				secrets[name] = secret
+				secretsMetadata[name], err = secretMetadata(name, "", data)
+				if err != nil {
+					return nil, nil, err
+				}
 			}
 		}
 	}
-	return secrets, nil
+	return secrets, secretsMetadata, nil
 }
 
-func (c *client) findSecretsFromName(ctx context.Context, candidates []string, ref esv1beta1.FindName) (map[string][]byte, error) {
+func (c *client) findSecretsFromName(ctx context.Context, candidates []string, ref esv1beta1.FindName, withMetadata bool) (map[string][]byte, map[string]esv1beta1.SecretMetadata, error) {
 	secrets := make(map[string][]byte)
+	secretsMetadata := make(map[string]esv1beta1.SecretMetadata)
 	matcher, err := find.New(ref)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, name := range candidates {
 		ok := matcher.MatchName(name)
@@ -96,14 +114,28 @@ func (c *client) findSecretsFromName(ctx context.Context, candidates []string, r
 				continue
 			}
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			if secret != nil {
-				secrets[name] = secret
+			if secret == nil {
+				continue
+			}
+			secrets[name] = secret
+			if !withMetadata {
+				continue
+			}
+			// the metadata is only read when it is requested
+			// as it needs another API call for each secret.
+			data, err := c.readMetadata(ctx, name)
+			if err != nil {
+				return nil, nil, err
+			}
+			secretsMetadata[name], err = secretMetadata(name, "", data)
+			if err != nil {
+				return nil, nil, err
 			}
 		}
 	}
-	return secrets, nil
+	return secrets, secretsMetadata, nil
 }
 
 func (c *client) listSecrets(ctx context.Context, path string) ([]string, error) {
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	vault "github.com/hashicorp/vault/api"
@@ -260,6 +261,68 @@ func TestGetAllSecrets(t *testing.T) {
 	}
 }
 
+func TestGetAllSecretsWithMetadata(t *testing.T) {
+	created := "2024-01-02T03:04:05.123456Z"
+	createdTime, _ := time.Parse(time.RFC3339Nano, created)
+	secrets := map[string]interface{}{
+		"secret1": map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"custom_metadata": map[string]interface{}{
+					"foo": "bar",
+				},
+				"current_version": 2,
+				"versions": map[string]interface{}{
+					"2": map[string]interface{}{
+						"created_time": created,
+					},
+				},
+			},
+			"data": map[string]interface{}{
+				"key": "value",
+			},
+		},
+		"default": map[string]interface{}{
+			"data": map[string]interface{}{
+				"empty": "true",
+			},
+			"metadata": map[string]interface{}{
+				"keys": []interface{}{"secret1"},
+			},
+		},
+	}
+	want := map[string]esv1beta1.SecretMetadata{
+		"secret1": {
+			Path:        "secret1",
+			Tags:        map[string]string{"foo": "bar"},
+			Version:     "2",
+			CreatedTime: createdTime,
+		},
+	}
+
+	cases := map[string]esv1beta1.ExternalSecretFind{
+		"FindByName": {Name: &esv1beta1.FindName{RegExp: "secret.*"}},
+		"FindByTag":  {Tags: map[string]string{"foo": "bar"}},
+	}
+	for name, find := range cases {
+		t.Run(name, func(t *testing.T) {
+			vStore := &client{
+				logical: &fake.Logical{
+					ListWithContextFn:         newListWithContextFn(secrets),
+					ReadWithDataWithContextFn: newReadtWithContextFn(secrets),
+				},
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV2).Spec.Provider.Vault,
+			}
+			_, metadata, err := vStore.GetAllSecretsWithMetadata(context.Background(), find)
+			if err != nil {
+				t.Fatalf("vault.GetAllSecretsWithMetadata(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(want, metadata); diff != "" {
+				t.Errorf("vault.GetAllSecretsWithMetadata(...): -want metadata, +got metadata:\n%s", diff)
+			}
+		})
+	}
+}
+
 func newListWithContextFn(secrets map[string]interface{}) func(ctx context.Context, path string) (*vault.Secret, error) {
 	return func(ctx context.Context, path string) (*vault.Secret, error) {
 		path = strings.TrimPrefix(path, "secret/metadata/")
@@ -298,6 +361,10 @@ func newReadtWithContextFn(secrets map[string]interface{}) func(ctx context.Cont
 			"data":            meta["data"],
 			"custom_metadata": metadata["custom_metadata"],
 		}
+		if current, ok := metadata["current_version"]; ok {
+			content["current_version"] = current
+			content["versions"] = metadata["versions"]
+		}
 		secret := &vault.Secret{
 			Data: content,
 		}
@@ -17,7 +17,9 @@ package webhook
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -28,11 +30,12 @@ import (
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	"github.com/external-secrets/external-secrets/pkg/common/webhook"
+	"github.com/external-secrets/external-secrets/pkg/find"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
 const (
-	errNotImplemented = "not implemented"
+	errNotConfigured = "%s request is not configured in the webhook provider"
 )
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -56,10 +59,20 @@ func init() {
 }
 
 // Capabilities return the provider supported capabilities (ReadOnly, WriteOnly, ReadWrite).
+// Writing secrets is only possible if the push request is configured, see StoreCapabilities.
 func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
 	return esv1beta1.SecretStoreReadOnly
 }
 
+// StoreCapabilities returns ReadWrite if the push request of the store is configured, ReadOnly otherwise.
+func (p *Provider) StoreCapabilities(store esv1beta1.GenericStore) esv1beta1.SecretStoreCapabilities {
+	provider, err := getProvider(store)
+	if err != nil || provider.Push == nil {
+		return esv1beta1.SecretStoreReadOnly
+	}
+	return esv1beta1.SecretStoreReadWrite
+}
+
 func (p *Provider) NewClient(_ context.Context, store esv1beta1.GenericStore, kube client.Client, namespace string) (esv1beta1.SecretsClient, error) {
 	wh := webhook.Webhook{
 		Kube:      kube,
@@ -104,23 +117,161 @@ func getProvider(store esv1beta1.GenericStore) (*webhook.Spec, error) {
 	return &out, err
 }
 
-func (w *WebHook) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
-	return fmt.Errorf(errNotImplemented)
+// DeleteSecret calls the delete request. A missing secret is not an error.
+func (w *WebHook) DeleteSecret(ctx context.Context, ref esv1beta1.PushSecretRemoteRef) error {
+	provider, err := getProvider(w.store)
+	if err != nil {
+		return fmt.Errorf("failed to get store: %w", err)
+	}
+	if provider.Delete == nil {
+		return fmt.Errorf(errNotConfigured, "delete")
+	}
+	data, err := w.wh.GetPushTemplateData(ctx, ref, provider.Secrets)
+	if err != nil {
+		return err
+	}
+	_, err = w.wh.DoRequest(ctx, provider.Delete, http.MethodDelete, data)
+	if errors.Is(err, esv1beta1.NoSecretErr) {
+		return nil
+	}
+	return err
 }
 
-func (w *WebHook) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
-	return false, fmt.Errorf(errNotImplemented)
+// SecretExists calls the exists request. A 404 response means the secret does not exist.
+func (w *WebHook) SecretExists(ctx context.Context, ref esv1beta1.PushSecretRemoteRef) (bool, error) {
+	provider, err := getProvider(w.store)
+	if err != nil {
+		return false, fmt.Errorf("failed to get store: %w", err)
+	}
+	if provider.Exists == nil {
+		return false, fmt.Errorf(errNotConfigured, "exists")
+	}
+	data, err := w.wh.GetPushTemplateData(ctx, ref, provider.Secrets)
+	if err != nil {
+		return false, err
+	}
+	_, err = w.wh.DoRequest(ctx, provider.Exists, http.MethodGet, data)
+	if errors.Is(err, esv1beta1.NoSecretErr) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PushSecret calls the push request.
+// The value is available in templates as .remoteRef.value, it is the json encoded
+// secret data if no secretKey is given.
+func (w *WebHook) PushSecret(ctx context.Context, secret *corev1.Secret, ref esv1beta1.PushSecretData) error {
+	provider, err := getProvider(w.store)
+	if err != nil {
+		return fmt.Errorf("failed to get store: %w", err)
+	}
+	if provider.Push == nil {
+		return fmt.Errorf(errNotConfigured, "push")
+	}
+	value, err := getPushValue(secret, ref.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	data, err := w.wh.GetPushTemplateData(ctx, ref, provider.Secrets)
+	if err != nil {
+		return err
+	}
+	// set after the secrets of the store have been added, so they can not shadow the values.
+	data["remoteRef"]["secretKey"] = ref.GetSecretKey()
+	data["remoteRef"]["value"] = string(value)
+	_, err = w.wh.DoRequest(ctx, provider.Push, http.MethodPost, data)
+	return err
 }
 
-// Not Implemented PushSecret.
-func (w *WebHook) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
-	return fmt.Errorf(errNotImplemented)
+func getPushValue(secret *corev1.Secret, secretKey string) ([]byte, error) {
+	if secretKey == "" {
+		values := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			values[k] = string(v)
+		}
+		return json.Marshal(values)
+	}
+	value, ok := secret.Data[secretKey]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret", secretKey)
+	}
+	return value, nil
 }
 
-// Empty GetAllSecrets.
-func (w *WebHook) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
-	// TO be implemented
-	return nil, fmt.Errorf(errNotImplemented)
+// GetAllSecrets calls the list request.
+// The result must be either an object of secret names to values
+// or a list of secret names, which are then read one by one.
+func (w *WebHook) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	provider, err := getProvider(w.store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store: %w", err)
+	}
+	if provider.List == nil {
+		return nil, fmt.Errorf(errNotConfigured, "list")
+	}
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		matcher, err = find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	data, err := w.wh.GetFindTemplateData(ctx, ref, provider.Secrets)
+	if err != nil {
+		return nil, err
+	}
+	result, err := w.wh.DoRequest(ctx, &provider.List.Request, http.MethodGet, data)
+	if err != nil {
+		return nil, err
+	}
+	jsondata := interface{}(nil)
+	if err := json.Unmarshal(result, &jsondata); err != nil {
+		return nil, fmt.Errorf("failed to parse response json: %w", err)
+	}
+	resultJSONPath, err := webhook.ExecuteTemplateString(provider.List.Result.JSONPath, data)
+	if err != nil {
+		return nil, err
+	}
+	if resultJSONPath != "" {
+		jsondata, err = jsonpath.Get(resultJSONPath, jsondata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get response path %s: %w", resultJSONPath, err)
+		}
+	}
+
+	secrets := make(map[string][]byte)
+	switch val := jsondata.(type) {
+	case map[string]any:
+		for name, value := range val {
+			if matcher != nil && !matcher.MatchName(name) {
+				continue
+			}
+			secrets[name], err = extractSecretData(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get value of %s: %w", name, err)
+			}
+		}
+	case []any:
+		for _, item := range val {
+			name, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("failed to get response (wrong type in list: %T)", item)
+			}
+			if matcher != nil && !matcher.MatchName(name) {
+				continue
+			}
+			secrets[name], err = w.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: name})
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("failed to get response (wrong type: %T)", jsondata)
+	}
+	return secrets, nil
 }
 
 func (w *WebHook) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
@@ -21,14 +21,18 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
 type testCase struct {
@@ -438,3 +442,185 @@ func makeClusterSecretStore(url string, args args) *esv1beta1.ClusterSecretStore
 	}
 	return store
 }
+
+type recordedRequest struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+// writeServer records all requests and answers with the status and body registered for a path.
+func writeServer(t *testing.T, responses map[string]string, notFound ...string) (*httptest.Server, *[]recordedRequest) {
+	t.Helper()
+	requests := []recordedRequest{}
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		requests = append(requests, recordedRequest{Method: req.Method, Path: req.URL.RequestURI(), Body: string(body)})
+		for _, p := range notFound {
+			if req.URL.Path == p {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+		}
+		_, _ = rw.Write([]byte(responses[req.URL.Path]))
+	}))
+	t.Cleanup(ts.Close)
+	return ts, &requests
+}
+
+func newWriteClient(t *testing.T, url string, configure func(*esv1beta1.WebhookProvider)) esv1beta1.SecretsClient {
+	t.Helper()
+	store := makeClusterSecretStore(url, args{URL: "/api/getsecret?id={{ .remoteRef.key }}"})
+	configure(store.Spec.Provider.Webhook)
+	client, err := (&Provider{}).NewClient(context.Background(), store, nil, "testnamespace")
+	if err != nil {
+		t.Fatalf("error creating client: %s", err.Error())
+	}
+	return client
+}
+
+func TestPushSecret(t *testing.T) {
+	ts, requests := writeServer(t, nil)
+	client := newWriteClient(t, ts.URL, func(p *esv1beta1.WebhookProvider) {
+		p.Push = &esv1beta1.WebhookRequest{
+			Method: http.MethodPut,
+			URL:    ts.URL + "/api/secrets/{{ .remoteRef.key }}?property={{ .remoteRef.property }}",
+			Body:   `{"key":"{{ .remoteRef.secretKey }}","value":{{ .remoteRef.value | toJson }}}`,
+		}
+	})
+	secret := &corev1.Secret{Data: map[string][]byte{"foo": []byte(`bar"baz`), "other": []byte("value")}}
+
+	err := client.PushSecret(context.Background(), secret, testingfake.PushSecretData{SecretKey: "foo", RemoteKey: "my secret", Property: "prop"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	err = client.PushSecret(context.Background(), secret, testingfake.PushSecretData{RemoteKey: "all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	want := []recordedRequest{
+		{Method: http.MethodPut, Path: "/api/secrets/my+secret?property=prop", Body: `{"key":"foo","value":"bar\"baz"}`},
+		{Method: http.MethodPut, Path: "/api/secrets/all?property=", Body: `{"key":"","value":"{\"foo\":\"bar\\\"baz\",\"other\":\"value\"}"}`},
+	}
+	if !reflect.DeepEqual(want, *requests) {
+		t.Errorf("unexpected requests:\nwant %#v\ngot  %#v", want, *requests)
+	}
+
+	err = client.PushSecret(context.Background(), secret, testingfake.PushSecretData{SecretKey: "missing", RemoteKey: "x"})
+	if err == nil || !strings.Contains(err.Error(), "key missing not found") {
+		t.Errorf("expected missing key error, got %v", err)
+	}
+
+	readOnly := newWriteClient(t, ts.URL, func(_ *esv1beta1.WebhookProvider) {})
+	err = readOnly.PushSecret(context.Background(), secret, testingfake.PushSecretData{SecretKey: "foo", RemoteKey: "x"})
+	if err == nil || err.Error() != "push request is not configured in the webhook provider" {
+		t.Errorf("expected not configured error, got %v", err)
+	}
+}
+
+func TestStoreCapabilities(t *testing.T) {
+	store := makeClusterSecretStore("http://example.com", args{URL: "/api/getsecret?id={{ .remoteRef.key }}"})
+	if got := (&Provider{}).StoreCapabilities(store); got != esv1beta1.SecretStoreReadOnly {
+		t.Errorf("expected ReadOnly without push request, got %s", got)
+	}
+	store.Spec.Provider.Webhook.Push = &esv1beta1.WebhookRequest{URL: "http://example.com/api/secrets"}
+	if got := (&Provider{}).StoreCapabilities(store); got != esv1beta1.SecretStoreReadWrite {
+		t.Errorf("expected ReadWrite with push request, got %s", got)
+	}
+}
+
+func TestDeleteSecret(t *testing.T) {
+	ts, requests := writeServer(t, nil, "/api/secrets/gone")
+	client := newWriteClient(t, ts.URL, func(p *esv1beta1.WebhookProvider) {
+		p.Delete = &esv1beta1.WebhookRequest{
+			URL: ts.URL + "/api/secrets/{{ .remoteRef.key }}",
+		}
+	})
+
+	if err := client.DeleteSecret(context.Background(), testingfake.PushSecretData{RemoteKey: "mykey"}); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+	// already deleted secrets are not an error
+	if err := client.DeleteSecret(context.Background(), testingfake.PushSecretData{RemoteKey: "gone"}); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+	want := []recordedRequest{
+		{Method: http.MethodDelete, Path: "/api/secrets/mykey"},
+		{Method: http.MethodDelete, Path: "/api/secrets/gone"},
+	}
+	if !reflect.DeepEqual(want, *requests) {
+		t.Errorf("unexpected requests:\nwant %#v\ngot  %#v", want, *requests)
+	}
+}
+
+func TestSecretExists(t *testing.T) {
+	ts, _ := writeServer(t, nil, "/api/secrets/gone")
+	client := newWriteClient(t, ts.URL, func(p *esv1beta1.WebhookProvider) {
+		p.Exists = &esv1beta1.WebhookRequest{
+			Method: http.MethodHead,
+			URL:    ts.URL + "/api/secrets/{{ .remoteRef.key }}",
+		}
+	})
+
+	exists, err := client.SecretExists(context.Background(), testingfake.PushSecretData{RemoteKey: "mykey"})
+	if err != nil || !exists {
+		t.Errorf("expected secret to exist, got %v, %v", exists, err)
+	}
+	exists, err = client.SecretExists(context.Background(), testingfake.PushSecretData{RemoteKey: "gone"})
+	if err != nil || exists {
+		t.Errorf("expected secret not to exist, got %v, %v", exists, err)
+	}
+}
+
+func TestGetAllSecrets(t *testing.T) {
+	ts, requests := writeServer(t, map[string]string{
+		"/api/secrets":   `{"items":{"db-password":"secret","db-user":"admin","api-token":"token"}}`,
+		"/api/names":     `{"names":["db-password","api-token"]}`,
+		"/api/getsecret": `value`,
+	})
+
+	client := newWriteClient(t, ts.URL, func(p *esv1beta1.WebhookProvider) {
+		p.List = &esv1beta1.WebhookListRequest{
+			WebhookRequest: esv1beta1.WebhookRequest{
+				URL: ts.URL + "/api/secrets?path={{ .find.path }}&env={{ .tags.env }}",
+			},
+			Result: esv1beta1.WebhookResult{JSONPath: "$.items"},
+		}
+	})
+	got, err := client.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Path: utils.Ptr("/app"),
+		Name: &esv1beta1.FindName{RegExp: "^db-"},
+		Tags: map[string]string{"env": "prod"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	want := map[string][]byte{"db-password": []byte("secret"), "db-user": []byte("admin")}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("unexpected secrets: want %v, got %v", want, got)
+	}
+	if (*requests)[0].Path != "/api/secrets?path=%2Fapp&env=prod" {
+		t.Errorf("unexpected request path: %s", (*requests)[0].Path)
+	}
+
+	// a list of names is read one by one
+	*requests = nil
+	client = newWriteClient(t, ts.URL, func(p *esv1beta1.WebhookProvider) {
+		p.List = &esv1beta1.WebhookListRequest{
+			WebhookRequest: esv1beta1.WebhookRequest{URL: ts.URL + "/api/names"},
+			Result:         esv1beta1.WebhookResult{JSONPath: "$.names"},
+		}
+	})
+	got, err = client.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	want = map[string][]byte{"db-password": []byte("value"), "api-token": []byte("value")}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("unexpected secrets: want %v, got %v", want, got)
+	}
+	if len(*requests) != 3 || (*requests)[1].Path != "/api/getsecret?id=db-password" {
+		t.Errorf("unexpected requests: %#v", *requests)
+	}
+}
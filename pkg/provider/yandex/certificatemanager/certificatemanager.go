@@ -68,6 +68,7 @@ func init() {
 		clock.NewRealClock(),
 		adaptInput,
 		newSecretGetter,
+		nil,
 		common.NewIamToken,
 		time.Hour,
 	)
@@ -660,6 +660,7 @@ func newCertificateManagerProvider(clock clock.Clock, fakeCertificateManagerServ
 		func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (common.SecretGetter, error) {
 			return newCertificateManagerSecretGetter(client.NewFakeCertificateManagerClient(fakeCertificateManagerServer))
 		},
+		nil,
 		func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (*common.IamToken, error) {
 			return fakeCertificateManagerServer.NewIamToken(authorizedKey), nil
 		},
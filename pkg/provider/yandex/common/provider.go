@@ -45,10 +45,13 @@ type YandexCloudProvider struct {
 	clock               clock2.Clock
 	adaptInputFunc      AdaptInputFunc
 	newSecretGetterFunc NewSecretGetterFunc
+	newSecretSetterFunc NewSecretSetterFunc // nil for read-only services
 	newIamTokenFunc     NewIamTokenFunc
 
 	secretGetteMap       map[string]SecretGetter // apiEndpoint -> SecretGetter
 	secretGetterMapMutex sync.Mutex
+	secretSetterMap      map[string]SecretSetter // apiEndpoint -> SecretSetter
+	secretSetterMapMutex sync.Mutex
 	iamTokenMap          map[iamTokenKey]*IamToken
 	iamTokenMapMutex     sync.Mutex
 }
@@ -64,6 +67,7 @@ func InitYandexCloudProvider(
 	clock clock2.Clock,
 	adaptInputFunc AdaptInputFunc,
 	newSecretGetterFunc NewSecretGetterFunc,
+	newSecretSetterFunc NewSecretSetterFunc,
 	newIamTokenFunc NewIamTokenFunc,
 	iamTokenCleanupDelay time.Duration,
 ) *YandexCloudProvider {
@@ -72,8 +76,10 @@ func InitYandexCloudProvider(
 		clock:               clock,
 		adaptInputFunc:      adaptInputFunc,
 		newSecretGetterFunc: newSecretGetterFunc,
+		newSecretSetterFunc: newSecretSetterFunc,
 		newIamTokenFunc:     newIamTokenFunc,
 		secretGetteMap:      make(map[string]SecretGetter),
+		secretSetterMap:     make(map[string]SecretSetter),
 		iamTokenMap:         make(map[iamTokenKey]*IamToken),
 	}
 
@@ -89,9 +95,9 @@ func InitYandexCloudProvider(
 	return provider
 }
 
-type NewSecretSetterFunc func()
 type AdaptInputFunc func(store esv1beta1.GenericStore) (*SecretsClientInput, error)
 type NewSecretGetterFunc func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (SecretGetter, error)
+type NewSecretSetterFunc func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (SecretSetter, error)
 type NewIamTokenFunc func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (*IamToken, error)
 
 type IamToken struct {
@@ -103,9 +109,13 @@ type SecretsClientInput struct {
 	APIEndpoint   string
 	AuthorizedKey esmeta.SecretKeySelector
 	CACertificate *esmeta.SecretKeySelector
+	FolderID      string
 }
 
 func (p *YandexCloudProvider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	if p.newSecretSetterFunc != nil {
+		return esv1beta1.SecretStoreReadWrite
+	}
 	return esv1beta1.SecretStoreReadOnly
 }
 
@@ -153,12 +163,20 @@ func (p *YandexCloudProvider) NewClient(ctx context.Context, store esv1beta1.Gen
 		return nil, fmt.Errorf("failed to create Yandex.Cloud client: %w", err)
 	}
 
+	var secretSetter SecretSetter
+	if p.newSecretSetterFunc != nil {
+		secretSetter, err = p.getOrCreateSecretSetter(ctx, input.APIEndpoint, &authorizedKey, caCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Yandex.Cloud client: %w", err)
+		}
+	}
+
 	iamToken, err := p.getOrCreateIamToken(ctx, input.APIEndpoint, &authorizedKey, caCertificateData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IAM token: %w", err)
 	}
 
-	return &yandexCloudSecretsClient{secretGetter, nil, iamToken.Token}, nil
+	return &yandexCloudSecretsClient{secretGetter, secretSetter, iamToken.Token, input.FolderID}, nil
 }
 
 func (p *YandexCloudProvider) getOrCreateSecretGetter(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (SecretGetter, error) {
@@ -177,6 +195,22 @@ func (p *YandexCloudProvider) getOrCreateSecretGetter(ctx context.Context, apiEn
 	return p.secretGetteMap[apiEndpoint], nil
 }
 
+func (p *YandexCloudProvider) getOrCreateSecretSetter(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (SecretSetter, error) {
+	p.secretSetterMapMutex.Lock()
+	defer p.secretSetterMapMutex.Unlock()
+
+	if _, ok := p.secretSetterMap[apiEndpoint]; !ok {
+		p.logger.Info("creating SecretSetter", "apiEndpoint", apiEndpoint)
+
+		secretSetter, err := p.newSecretSetterFunc(ctx, apiEndpoint, authorizedKey, caCertificate)
+		if err != nil {
+			return nil, err
+		}
+		p.secretSetterMap[apiEndpoint] = secretSetter
+	}
+	return p.secretSetterMap[apiEndpoint], nil
+}
+
 func (p *YandexCloudProvider) getOrCreateIamToken(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (*IamToken, error) {
 	p.iamTokenMapMutex.Lock()
 	defer p.iamTokenMapMutex.Unlock()
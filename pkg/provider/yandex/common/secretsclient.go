@@ -24,7 +24,9 @@ import (
 )
 
 const (
-	errNotImplemented = "not implemented"
+	errNotImplemented   = "not implemented"
+	errMissingFolderID  = "folderID must be set in the SecretStore to push, delete or find secrets"
+	errMissingSecretKey = "secret key %q not found in secret %s/%s"
 )
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -35,22 +37,57 @@ type yandexCloudSecretsClient struct {
 	secretGetter SecretGetter
 	secretSetter SecretSetter
 	iamToken     string
+	folderID     string
 }
 
 func (c *yandexCloudSecretsClient) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
-	return c.secretGetter.GetSecret(ctx, c.iamToken, ref.Key, ref.Version, ref.Property)
+	resourceID, err := c.resourceID(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return c.secretGetter.GetSecret(ctx, c.iamToken, resourceID, ref.Version, ref.Property)
 }
 
-func (c *yandexCloudSecretsClient) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
-	return fmt.Errorf(errNotImplemented)
+func (c *yandexCloudSecretsClient) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	if err := c.checkSecretSetter(); err != nil {
+		return err
+	}
+	return c.secretSetter.DeleteSecret(ctx, c.iamToken, c.folderID, remoteRef.GetRemoteKey(), remoteRef.GetProperty())
 }
 
-func (c *yandexCloudSecretsClient) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
-	return false, fmt.Errorf(errNotImplemented)
+func (c *yandexCloudSecretsClient) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	if err := c.checkSecretSetter(); err != nil {
+		return false, err
+	}
+	return c.secretSetter.SecretExists(ctx, c.iamToken, c.folderID, remoteRef.GetRemoteKey(), remoteRef.GetProperty())
 }
 
-func (c *yandexCloudSecretsClient) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
-	return fmt.Errorf(errNotImplemented)
+// PushSecret writes the selected key of the Kubernetes secret as a payload entry named after the property
+// (or the secret key if the property is empty). If no secret key is selected, every key of the Kubernetes
+// secret is written as a separate payload entry.
+func (c *yandexCloudSecretsClient) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if err := c.checkSecretSetter(); err != nil {
+		return err
+	}
+
+	entries := make(map[string][]byte)
+	if data.GetSecretKey() == "" {
+		for key, value := range secret.Data {
+			entries[key] = value
+		}
+	} else {
+		value, ok := secret.Data[data.GetSecretKey()]
+		if !ok {
+			return fmt.Errorf(errMissingSecretKey, data.GetSecretKey(), secret.Namespace, secret.Name)
+		}
+		entryKey := data.GetProperty()
+		if entryKey == "" {
+			entryKey = data.GetSecretKey()
+		}
+		entries[entryKey] = value
+	}
+
+	return c.secretSetter.SetSecret(ctx, c.iamToken, c.folderID, data.GetRemoteKey(), entries)
 }
 
 func (c *yandexCloudSecretsClient) Validate() (esv1beta1.ValidationResult, error) {
@@ -58,14 +95,39 @@ func (c *yandexCloudSecretsClient) Validate() (esv1beta1.ValidationResult, error
 }
 
 func (c *yandexCloudSecretsClient) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
-	return c.secretGetter.GetSecretMap(ctx, c.iamToken, ref.Key, ref.Version)
+	resourceID, err := c.resourceID(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return c.secretGetter.GetSecretMap(ctx, c.iamToken, resourceID, ref.Version)
 }
 
-func (c *yandexCloudSecretsClient) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
-	// TO be implemented
-	return nil, fmt.Errorf(errNotImplemented)
+// resourceID returns the ID of the secret the key refers to. With a folder, secrets are addressed by name
+// like pushed and found secrets, otherwise the key is the ID of the secret.
+func (c *yandexCloudSecretsClient) resourceID(ctx context.Context, key string) (string, error) {
+	if c.secretSetter == nil || c.folderID == "" {
+		return key, nil
+	}
+	return c.secretSetter.FindSecretID(ctx, c.iamToken, c.folderID, key)
+}
+
+func (c *yandexCloudSecretsClient) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if err := c.checkSecretSetter(); err != nil {
+		return nil, err
+	}
+	return c.secretSetter.GetAllSecrets(ctx, c.iamToken, c.folderID, ref)
 }
 
 func (c *yandexCloudSecretsClient) Close(_ context.Context) error {
 	return nil
 }
+
+func (c *yandexCloudSecretsClient) checkSecretSetter() error {
+	if c.secretSetter == nil {
+		return fmt.Errorf(errNotImplemented)
+	}
+	if c.folderID == "" {
+		return fmt.Errorf(errMissingFolderID)
+	}
+	return nil
+}
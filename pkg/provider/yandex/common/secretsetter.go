@@ -14,6 +14,24 @@ limitations under the License.
 
 package common
 
+import (
+	"context"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// Writes, lists and deletes the secrets of a remote Yandex.Cloud service.
+// Secrets are addressed by their name within the given folder.
+// Only secrets created by external-secrets are updated or deleted.
 type SecretSetter interface {
-	SetSecret() error
+	// Merges the given entries into the latest version of the secret, creating the secret if it does not exist.
+	SetSecret(ctx context.Context, iamToken, folderID, name string, entries map[string][]byte) error
+	// Deletes the given entry of the secret or the whole secret if the property is empty.
+	DeleteSecret(ctx context.Context, iamToken, folderID, name, property string) error
+	// Returns the ID of the secret with the given name.
+	FindSecretID(ctx context.Context, iamToken, folderID, name string) (string, error)
+	// Checks whether the secret (or its entry, if the property is not empty) exists.
+	SecretExists(ctx context.Context, iamToken, folderID, name, property string) (bool, error)
+	// Returns the secrets of the folder matching the given criteria, keyed by secret name.
+	GetAllSecrets(ctx context.Context, iamToken, folderID string, find esv1beta1.ExternalSecretFind) (map[string][]byte, error)
 }
@@ -20,7 +20,11 @@ import (
 	api "github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
 )
 
-// Requests the payload of the given secret from Lockbox.
+// Requests the payload of the given secret from Lockbox and manages the secrets of a folder.
 type LockboxClient interface {
 	GetPayloadEntries(ctx context.Context, iamToken, secretID, versionID string) ([]*api.Payload_Entry, error)
+	ListSecrets(ctx context.Context, iamToken, folderID string) ([]*api.Secret, error)
+	CreateSecret(ctx context.Context, iamToken, folderID, name string, labels map[string]string, entries []*api.PayloadEntryChange) (string, error)
+	AddVersion(ctx context.Context, iamToken, secretID string, entries []*api.PayloadEntryChange) (string, error)
+	DeleteSecret(ctx context.Context, iamToken, secretID string) error
 }
@@ -42,6 +42,22 @@ func (c *fakeLockboxClient) GetPayloadEntries(_ context.Context, iamToken, secre
 	return c.fakeLockboxServer.getEntries(iamToken, secretID, versionID)
 }
 
+func (c *fakeLockboxClient) ListSecrets(_ context.Context, iamToken, folderID string) ([]*api.Secret, error) {
+	return c.fakeLockboxServer.listSecrets(iamToken, folderID)
+}
+
+func (c *fakeLockboxClient) CreateSecret(_ context.Context, iamToken, folderID, name string, labels map[string]string, entries []*api.PayloadEntryChange) (string, error) {
+	return c.fakeLockboxServer.createSecret(iamToken, folderID, name, labels, entries)
+}
+
+func (c *fakeLockboxClient) AddVersion(_ context.Context, iamToken, secretID string, entries []*api.PayloadEntryChange) (string, error) {
+	return c.fakeLockboxServer.addVersion(iamToken, secretID, entries)
+}
+
+func (c *fakeLockboxClient) DeleteSecret(_ context.Context, iamToken, secretID string) error {
+	return c.fakeLockboxServer.deleteSecret(iamToken, secretID)
+}
+
 // Fakes Yandex Lockbox service backend.
 type FakeLockboxServer struct {
 	secretMap  map[secretKey]secretValue   // secret specific data
@@ -58,6 +74,9 @@ type secretKey struct {
 
 type secretValue struct {
 	expectedAuthorizedKey *iamkey.Key // authorized key expected to access the secret
+	folderID              string
+	name                  string
+	labels                map[string]string
 }
 
 type versionKey struct {
@@ -89,10 +108,14 @@ func NewFakeLockboxServer(clock clock.Clock, tokenExpirationDuration time.Durati
 }
 
 func (s *FakeLockboxServer) CreateSecret(authorizedKey *iamkey.Key, entries ...*api.Payload_Entry) (string, string) {
+	return s.CreateSecretInFolder(authorizedKey, "", "", nil, entries...)
+}
+
+func (s *FakeLockboxServer) CreateSecretInFolder(authorizedKey *iamkey.Key, folderID, name string, labels map[string]string, entries ...*api.Payload_Entry) (string, string) {
 	secretID := uuid.NewString()
 	versionID := uuid.NewString()
 
-	s.secretMap[secretKey{secretID}] = secretValue{authorizedKey}
+	s.secretMap[secretKey{secretID}] = secretValue{authorizedKey, folderID, name, labels}
 	s.versionMap[versionKey{secretID, ""}] = versionValue{entries} // empty versionID corresponds to the latest version
 	s.versionMap[versionKey{secretID, versionID}] = versionValue{entries}
 
@@ -122,6 +145,74 @@ func (s *FakeLockboxServer) getEntries(iamToken, secretID, versionID string) ([]
 	if _, ok := s.versionMap[versionKey{secretID, versionID}]; !ok {
 		return nil, fmt.Errorf("version not found")
 	}
+	if err := s.authorize(iamToken, secretID); err != nil {
+		return nil, err
+	}
+
+	return s.versionMap[versionKey{secretID, versionID}].entries, nil
+}
+
+func (s *FakeLockboxServer) listSecrets(iamToken, folderID string) ([]*api.Secret, error) {
+	authorizedKey, err := s.authenticate(iamToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []*api.Secret
+	for key, value := range s.secretMap {
+		if value.folderID != folderID || !sameKey(authorizedKey, value.expectedAuthorizedKey) {
+			continue
+		}
+		secrets = append(secrets, &api.Secret{
+			Id:       key.secretID,
+			FolderId: value.folderID,
+			Name:     value.name,
+			Labels:   value.labels,
+			Status:   api.Secret_ACTIVE,
+		})
+	}
+	return secrets, nil
+}
+
+func (s *FakeLockboxServer) createSecret(iamToken, folderID, name string, labels map[string]string, entryChanges []*api.PayloadEntryChange) (string, error) {
+	authorizedKey, err := s.authenticate(iamToken)
+	if err != nil {
+		return "", err
+	}
+	for _, value := range s.secretMap {
+		if value.folderID == folderID && value.name == name {
+			return "", fmt.Errorf("secret with name %q already exists", name)
+		}
+	}
+
+	secretID, _ := s.CreateSecretInFolder(authorizedKey, folderID, name, labels, toPayloadEntries(entryChanges)...)
+	return secretID, nil
+}
+
+func (s *FakeLockboxServer) addVersion(iamToken, secretID string, entryChanges []*api.PayloadEntryChange) (string, error) {
+	if _, ok := s.secretMap[secretKey{secretID}]; !ok {
+		return "", fmt.Errorf("secret not found")
+	}
+	if err := s.authorize(iamToken, secretID); err != nil {
+		return "", err
+	}
+
+	return s.AddVersion(secretID, toPayloadEntries(entryChanges)...), nil
+}
+
+func (s *FakeLockboxServer) deleteSecret(iamToken, secretID string) error {
+	if _, ok := s.secretMap[secretKey{secretID}]; !ok {
+		return fmt.Errorf("secret not found")
+	}
+	if err := s.authorize(iamToken, secretID); err != nil {
+		return err
+	}
+
+	delete(s.secretMap, secretKey{secretID})
+	return nil
+}
+
+func (s *FakeLockboxServer) authenticate(iamToken string) (*iamkey.Key, error) {
 	if _, ok := s.tokenMap[tokenKey{iamToken}]; !ok {
 		return nil, fmt.Errorf("unauthenticated")
 	}
@@ -129,9 +220,35 @@ func (s *FakeLockboxServer) getEntries(iamToken, secretID, versionID string) ([]
 	if s.tokenMap[tokenKey{iamToken}].expiresAt.Before(s.clock.CurrentTime()) {
 		return nil, fmt.Errorf("iam token expired")
 	}
-	if !cmp.Equal(s.tokenMap[tokenKey{iamToken}].authorizedKey, s.secretMap[secretKey{secretID}].expectedAuthorizedKey, cmpopts.IgnoreUnexported(iamkey.Key{})) {
-		return nil, fmt.Errorf("permission denied")
+	return s.tokenMap[tokenKey{iamToken}].authorizedKey, nil
+}
+
+func (s *FakeLockboxServer) authorize(iamToken, secretID string) error {
+	authorizedKey, err := s.authenticate(iamToken)
+	if err != nil {
+		return err
+	}
+	if !sameKey(authorizedKey, s.secretMap[secretKey{secretID}].expectedAuthorizedKey) {
+		return fmt.Errorf("permission denied")
 	}
+	return nil
+}
 
-	return s.versionMap[versionKey{secretID, versionID}].entries, nil
+func sameKey(a, b *iamkey.Key) bool {
+	return cmp.Equal(a, b, cmpopts.IgnoreUnexported(iamkey.Key{}))
+}
+
+func toPayloadEntries(entryChanges []*api.PayloadEntryChange) []*api.Payload_Entry {
+	entries := make([]*api.Payload_Entry, 0, len(entryChanges))
+	for _, change := range entryChanges {
+		entry := &api.Payload_Entry{Key: change.Key}
+		switch change.Value.(type) {
+		case *api.PayloadEntryChange_TextValue:
+			entry.Value = &api.Payload_Entry_TextValue{TextValue: change.GetTextValue()}
+		case *api.PayloadEntryChange_BinaryValue:
+			entry.Value = &api.Payload_Entry_BinaryValue{BinaryValue: change.GetBinaryValue()}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
 }
@@ -16,10 +16,13 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	api "github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"github.com/yandex-cloud/go-sdk/iamkey"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/external-secrets/external-secrets/pkg/provider/yandex/common"
 )
@@ -27,10 +30,11 @@ import (
 // Real/gRPC implementation of LockboxClient.
 type grpcLockboxClient struct {
 	lockboxPayloadClient api.PayloadServiceClient
+	lockboxSecretClient  api.SecretServiceClient
 }
 
 func NewGrpcLockboxClient(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (LockboxClient, error) {
-	conn, err := common.NewGrpcConnection(
+	payloadConn, err := common.NewGrpcConnection(
 		ctx,
 		apiEndpoint,
 		"lockbox-payload", // taken from https://api.cloud.yandex.net/endpoints
@@ -40,7 +44,17 @@ func NewGrpcLockboxClient(ctx context.Context, apiEndpoint string, authorizedKey
 	if err != nil {
 		return nil, err
 	}
-	return &grpcLockboxClient{api.NewPayloadServiceClient(conn)}, nil
+	secretConn, err := common.NewGrpcConnection(
+		ctx,
+		apiEndpoint,
+		"lockbox", // taken from https://api.cloud.yandex.net/endpoints
+		authorizedKey,
+		caCertificate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcLockboxClient{api.NewPayloadServiceClient(payloadConn), api.NewSecretServiceClient(secretConn)}, nil
 }
 
 func (c *grpcLockboxClient) GetPayloadEntries(ctx context.Context, iamToken, secretID, versionID string) ([]*api.Payload_Entry, error) {
@@ -57,3 +71,90 @@ func (c *grpcLockboxClient) GetPayloadEntries(ctx context.Context, iamToken, sec
 	}
 	return payload.Entries, nil
 }
+
+func (c *grpcLockboxClient) ListSecrets(ctx context.Context, iamToken, folderID string) ([]*api.Secret, error) {
+	var secrets []*api.Secret
+	pageToken := ""
+	for {
+		response, err := c.lockboxSecretClient.List(
+			ctx,
+			&api.ListSecretsRequest{
+				FolderId:  folderID,
+				PageToken: pageToken,
+			},
+			grpc.PerRPCCredentials(common.PerRPCCredentials{IamToken: iamToken}),
+		)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, response.Secrets...)
+		if response.NextPageToken == "" {
+			return secrets, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+func (c *grpcLockboxClient) CreateSecret(ctx context.Context, iamToken, folderID, name string, labels map[string]string, entries []*api.PayloadEntryChange) (string, error) {
+	op, err := c.lockboxSecretClient.Create(
+		ctx,
+		&api.CreateSecretRequest{
+			FolderId:              folderID,
+			Name:                  name,
+			Labels:                labels,
+			VersionPayloadEntries: entries,
+		},
+		grpc.PerRPCCredentials(common.PerRPCCredentials{IamToken: iamToken}),
+	)
+	if err != nil {
+		return "", err
+	}
+	var metadata api.CreateSecretMetadata
+	if err := unmarshalOperationMetadata(op, &metadata); err != nil {
+		return "", err
+	}
+	return metadata.SecretId, nil
+}
+
+func (c *grpcLockboxClient) AddVersion(ctx context.Context, iamToken, secretID string, entries []*api.PayloadEntryChange) (string, error) {
+	op, err := c.lockboxSecretClient.AddVersion(
+		ctx,
+		&api.AddVersionRequest{
+			SecretId:       secretID,
+			PayloadEntries: entries,
+		},
+		grpc.PerRPCCredentials(common.PerRPCCredentials{IamToken: iamToken}),
+	)
+	if err != nil {
+		return "", err
+	}
+	var metadata api.AddVersionMetadata
+	if err := unmarshalOperationMetadata(op, &metadata); err != nil {
+		return "", err
+	}
+	return metadata.VersionId, nil
+}
+
+func (c *grpcLockboxClient) DeleteSecret(ctx context.Context, iamToken, secretID string) error {
+	op, err := c.lockboxSecretClient.Delete(
+		ctx,
+		&api.DeleteSecretRequest{
+			SecretId: secretID,
+		},
+		grpc.PerRPCCredentials(common.PerRPCCredentials{IamToken: iamToken}),
+	)
+	if err != nil {
+		return err
+	}
+	if opErr := op.GetError(); opErr != nil {
+		return fmt.Errorf("operation %s failed: %s", op.Id, opErr.Message)
+	}
+	return nil
+}
+
+func unmarshalOperationMetadata(op *operation.Operation, metadata proto.Message) error {
+	if opErr := op.GetError(); opErr != nil {
+		return fmt.Errorf("operation %s failed: %s", op.Id, opErr.Message)
+	}
+	return op.GetMetadata().UnmarshalTo(metadata)
+}
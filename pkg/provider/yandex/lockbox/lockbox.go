@@ -51,6 +51,7 @@ func adaptInput(store esv1beta1.GenericStore) (*common.SecretsClientInput, error
 		APIEndpoint:   storeSpecYandexLockbox.APIEndpoint,
 		AuthorizedKey: storeSpecYandexLockbox.Auth.AuthorizedKey,
 		CACertificate: caCertificate,
+		FolderID:      storeSpecYandexLockbox.FolderID,
 	}, nil
 }
 
@@ -62,12 +63,21 @@ func newSecretGetter(ctx context.Context, apiEndpoint string, authorizedKey *iam
 	return newLockboxSecretGetter(lockboxClient)
 }
 
+func newSecretSetter(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (common.SecretSetter, error) {
+	lockboxClient, err := client.NewGrpcLockboxClient(ctx, apiEndpoint, authorizedKey, caCertificate)
+	if err != nil {
+		return nil, err
+	}
+	return newLockboxSecretSetter(lockboxClient)
+}
+
 func init() {
 	provider := common.InitYandexCloudProvider(
 		log,
 		clock.NewRealClock(),
 		adaptInput,
 		newSecretGetter,
+		newSecretSetter,
 		common.NewIamToken,
 		time.Hour,
 	)
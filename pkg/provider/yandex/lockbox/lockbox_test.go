@@ -33,6 +33,7 @@ import (
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
 	"github.com/external-secrets/external-secrets/pkg/provider/yandex/common"
 	"github.com/external-secrets/external-secrets/pkg/provider/yandex/common/clock"
 	"github.com/external-secrets/external-secrets/pkg/provider/yandex/lockbox/client"
@@ -42,6 +43,7 @@ const (
 	errMissingKey                    = "invalid Yandex Lockbox SecretStore resource: missing AuthorizedKey Name"
 	errSecretPayloadPermissionDenied = "unable to request secret payload to get secret: permission denied"
 	errSecretPayloadNotFound         = "unable to request secret payload to get secret: secret not found"
+	errMissingFolderID               = "folderID must be set in the SecretStore to push, delete or find secrets"
 )
 
 func TestNewClient(t *testing.T) {
@@ -603,6 +605,212 @@ func TestGetSecretMapByVersionID(t *testing.T) {
 	tassert.Equal(t, map[string][]byte{newKey: []byte(newVal)}, data)
 }
 
+func TestPushSecret(t *testing.T) {
+	ctx := context.Background()
+	namespace := uuid.NewString()
+	authorizedKey := newFakeAuthorizedKey()
+	folderID := uuid.NewString()
+
+	fakeClock := clock.NewFakeClock()
+	fakeLockboxServer := client.NewFakeLockboxServer(fakeClock, time.Hour)
+
+	k8sClient := clientfake.NewClientBuilder().Build()
+	const authorizedKeySecretName = "authorizedKeySecretName"
+	const authorizedKeySecretKey = "authorizedKeySecretKey"
+	err := createK8sSecret(ctx, t, k8sClient, namespace, authorizedKeySecretName, authorizedKeySecretKey, toJSON(t, authorizedKey))
+	tassert.Nil(t, err)
+	store := newYandexLockboxSecretStore("", namespace, authorizedKeySecretName, authorizedKeySecretKey)
+	store.GetSpec().Provider.YandexLockbox.FolderID = folderID
+
+	provider := newLockboxProvider(fakeClock, fakeLockboxServer)
+	tassert.Equal(t, esv1beta1.SecretStoreReadWrite, provider.Capabilities())
+	secretsClient, err := provider.NewClient(ctx, store, k8sClient, namespace)
+	tassert.Nil(t, err)
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"k1": []byte("v1"),
+			"k2": {0xff, 0xfe},
+		},
+	}
+	remoteRef := testingfake.PushSecretData{SecretKey: "k1", RemoteKey: "secretName", Property: "p1"}
+
+	exists, err := secretsClient.SecretExists(ctx, remoteRef)
+	tassert.Nil(t, err)
+	tassert.False(t, exists)
+
+	err = secretsClient.PushSecret(ctx, secret, remoteRef)
+	tassert.Nil(t, err)
+
+	exists, err = secretsClient.SecretExists(ctx, remoteRef)
+	tassert.Nil(t, err)
+	tassert.True(t, exists)
+
+	err = secretsClient.PushSecret(ctx, secret, testingfake.PushSecretData{SecretKey: "k2", RemoteKey: "secretName"})
+	tassert.Nil(t, err)
+
+	data, err := secretsClient.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{})
+	tassert.Nil(t, err)
+	tassert.Equal(t, 1, len(data))
+	tassert.Equal(
+		t,
+		map[string]string{
+			"p1": "v1",
+			"k2": base64([]byte{0xff, 0xfe}),
+		},
+		unmarshalStringMap(t, data["secretName"]),
+	)
+
+	// pushed secrets are read back by name
+	value, err := secretsClient.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "secretName", Property: "p1"})
+	tassert.Nil(t, err)
+	tassert.Equal(t, []byte("v1"), value)
+	valueMap, err := secretsClient.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "secretName"})
+	tassert.Nil(t, err)
+	tassert.Equal(t, map[string][]byte{"p1": []byte("v1"), "k2": {0xff, 0xfe}}, valueMap)
+	_, err = secretsClient.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"})
+	tassert.ErrorIs(t, err, esv1beta1.NoSecretErr)
+
+	err = secretsClient.PushSecret(ctx, secret, testingfake.PushSecretData{SecretKey: "missing", RemoteKey: "secretName"})
+	tassert.EqualError(t, err, "secret key \"missing\" not found in secret /")
+
+	// secrets which have not been created by external-secrets are not updated
+	fakeLockboxServer.CreateSecretInFolder(authorizedKey, folderID, "unmanaged", nil, textEntry("k1", "v1"))
+	err = secretsClient.PushSecret(ctx, secret, testingfake.PushSecretData{SecretKey: "k1", RemoteKey: "unmanaged"})
+	tassert.EqualError(t, err, "secret \"unmanaged\" is not managed by external-secrets")
+}
+
+func TestPushSecretWithoutFolderID(t *testing.T) {
+	ctx := context.Background()
+	namespace := uuid.NewString()
+	authorizedKey := newFakeAuthorizedKey()
+
+	fakeClock := clock.NewFakeClock()
+	fakeLockboxServer := client.NewFakeLockboxServer(fakeClock, time.Hour)
+
+	k8sClient := clientfake.NewClientBuilder().Build()
+	const authorizedKeySecretName = "authorizedKeySecretName"
+	const authorizedKeySecretKey = "authorizedKeySecretKey"
+	err := createK8sSecret(ctx, t, k8sClient, namespace, authorizedKeySecretName, authorizedKeySecretKey, toJSON(t, authorizedKey))
+	tassert.Nil(t, err)
+	store := newYandexLockboxSecretStore("", namespace, authorizedKeySecretName, authorizedKeySecretKey)
+
+	provider := newLockboxProvider(fakeClock, fakeLockboxServer)
+	secretsClient, err := provider.NewClient(ctx, store, k8sClient, namespace)
+	tassert.Nil(t, err)
+
+	secret := &corev1.Secret{Data: map[string][]byte{"k1": []byte("v1")}}
+	err = secretsClient.PushSecret(ctx, secret, testingfake.PushSecretData{SecretKey: "k1", RemoteKey: "secretName"})
+	tassert.EqualError(t, err, errMissingFolderID)
+	_, err = secretsClient.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{})
+	tassert.EqualError(t, err, errMissingFolderID)
+}
+
+func TestDeleteSecret(t *testing.T) {
+	ctx := context.Background()
+	namespace := uuid.NewString()
+	authorizedKey := newFakeAuthorizedKey()
+	folderID := uuid.NewString()
+
+	fakeClock := clock.NewFakeClock()
+	fakeLockboxServer := client.NewFakeLockboxServer(fakeClock, time.Hour)
+	fakeLockboxServer.CreateSecretInFolder(authorizedKey, folderID, "secretName", map[string]string{"managed-by": "external-secrets"},
+		textEntry("k1", "v1"),
+		textEntry("k2", "v2"),
+	)
+	fakeLockboxServer.CreateSecretInFolder(authorizedKey, folderID, "unmanaged", nil, textEntry("k1", "v1"))
+
+	k8sClient := clientfake.NewClientBuilder().Build()
+	const authorizedKeySecretName = "authorizedKeySecretName"
+	const authorizedKeySecretKey = "authorizedKeySecretKey"
+	err := createK8sSecret(ctx, t, k8sClient, namespace, authorizedKeySecretName, authorizedKeySecretKey, toJSON(t, authorizedKey))
+	tassert.Nil(t, err)
+	store := newYandexLockboxSecretStore("", namespace, authorizedKeySecretName, authorizedKeySecretKey)
+	store.GetSpec().Provider.YandexLockbox.FolderID = folderID
+
+	provider := newLockboxProvider(fakeClock, fakeLockboxServer)
+	secretsClient, err := provider.NewClient(ctx, store, k8sClient, namespace)
+	tassert.Nil(t, err)
+
+	err = secretsClient.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "secretName", Property: "k1"})
+	tassert.Nil(t, err)
+
+	data, err := secretsClient.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{})
+	tassert.Nil(t, err)
+	tassert.Equal(t, map[string]string{"k2": "v2"}, unmarshalStringMap(t, data["secretName"]))
+
+	err = secretsClient.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "secretName"})
+	tassert.Nil(t, err)
+
+	exists, err := secretsClient.SecretExists(ctx, testingfake.PushSecretData{RemoteKey: "secretName"})
+	tassert.Nil(t, err)
+	tassert.False(t, exists)
+
+	// deleting a missing secret is a no-op
+	err = secretsClient.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "secretName"})
+	tassert.Nil(t, err)
+
+	// secrets which have not been created by external-secrets are kept
+	err = secretsClient.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "unmanaged", Property: "k1"})
+	tassert.Nil(t, err)
+	err = secretsClient.DeleteSecret(ctx, testingfake.PushSecretData{RemoteKey: "unmanaged"})
+	tassert.Nil(t, err)
+	exists, err = secretsClient.SecretExists(ctx, testingfake.PushSecretData{RemoteKey: "unmanaged", Property: "k1"})
+	tassert.Nil(t, err)
+	tassert.True(t, exists)
+}
+
+func TestGetAllSecrets(t *testing.T) {
+	ctx := context.Background()
+	namespace := uuid.NewString()
+	authorizedKey := newFakeAuthorizedKey()
+	folderID := uuid.NewString()
+
+	fakeClock := clock.NewFakeClock()
+	fakeLockboxServer := client.NewFakeLockboxServer(fakeClock, time.Hour)
+	fakeLockboxServer.CreateSecretInFolder(authorizedKey, folderID, "app-db", map[string]string{"team": "a"}, textEntry("k1", "v1"))
+	fakeLockboxServer.CreateSecretInFolder(authorizedKey, folderID, "app-cache", map[string]string{"team": "b"}, textEntry("k2", "v2"))
+	fakeLockboxServer.CreateSecretInFolder(authorizedKey, folderID, "other", map[string]string{"team": "a"}, textEntry("k3", "v3"))
+	fakeLockboxServer.CreateSecretInFolder(authorizedKey, uuid.NewString(), "app-foreign", nil, textEntry("k4", "v4"))
+	fakeLockboxServer.CreateSecretInFolder(newFakeAuthorizedKey(), folderID, "app-forbidden", nil, textEntry("k5", "v5"))
+
+	k8sClient := clientfake.NewClientBuilder().Build()
+	const authorizedKeySecretName = "authorizedKeySecretName"
+	const authorizedKeySecretKey = "authorizedKeySecretKey"
+	err := createK8sSecret(ctx, t, k8sClient, namespace, authorizedKeySecretName, authorizedKeySecretKey, toJSON(t, authorizedKey))
+	tassert.Nil(t, err)
+	store := newYandexLockboxSecretStore("", namespace, authorizedKeySecretName, authorizedKeySecretKey)
+	store.GetSpec().Provider.YandexLockbox.FolderID = folderID
+
+	provider := newLockboxProvider(fakeClock, fakeLockboxServer)
+	secretsClient, err := provider.NewClient(ctx, store, k8sClient, namespace)
+	tassert.Nil(t, err)
+
+	data, err := secretsClient.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "^app-"},
+	})
+	tassert.Nil(t, err)
+	tassert.ElementsMatch(t, []string{"app-db", "app-cache"}, keys(data))
+
+	data, err = secretsClient.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{
+		Tags: map[string]string{"team": "a"},
+	})
+	tassert.Nil(t, err)
+	tassert.ElementsMatch(t, []string{"app-db", "other"}, keys(data))
+	tassert.Equal(t, map[string]string{"k1": "v1"}, unmarshalStringMap(t, data["app-db"]))
+
+	data, err = secretsClient.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "^app-"},
+		Tags: map[string]string{"team": "a"},
+	})
+	tassert.Nil(t, err)
+	tassert.ElementsMatch(t, []string{"app-db"}, keys(data))
+
+	path := "some/path"
+	_, err = secretsClient.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{Path: &path})
+	tassert.EqualError(t, err, "find by path is not supported by Yandex Lockbox")
+}
+
 // helper functions
 
 func newLockboxProvider(clock clock.Clock, fakeLockboxServer *client.FakeLockboxServer) *common.YandexCloudProvider {
@@ -613,6 +821,9 @@ func newLockboxProvider(clock clock.Clock, fakeLockboxServer *client.FakeLockbox
 		func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (common.SecretGetter, error) {
 			return newLockboxSecretGetter(client.NewFakeLockboxClient(fakeLockboxServer))
 		},
+		func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (common.SecretSetter, error) {
+			return newLockboxSecretSetter(client.NewFakeLockboxClient(fakeLockboxServer))
+		},
 		func(ctx context.Context, apiEndpoint string, authorizedKey *iamkey.Key, caCertificate []byte) (*common.IamToken, error) {
 			return fakeLockboxServer.NewIamToken(authorizedKey), nil
 		},
@@ -695,6 +906,14 @@ func unmarshalStringMap(t *testing.T, data []byte) map[string]string {
 	return stringMap
 }
 
+func keys(data map[string][]byte) []string {
+	result := make([]string, 0, len(data))
+	for key := range data {
+		result = append(result, key)
+	}
+	return result
+}
+
 func base64(data []byte) string {
 	return b64.StdEncoding.EncodeToString(data)
 }
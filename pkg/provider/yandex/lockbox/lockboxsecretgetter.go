@@ -43,19 +43,7 @@ func (g *lockboxSecretGetter) GetSecret(ctx context.Context, iamToken, resourceI
 	}
 
 	if property == "" {
-		keyToValue := make(map[string]interface{}, len(entries))
-		for _, entry := range entries {
-			value, err := getValueAsIs(entry)
-			if err != nil {
-				return nil, err
-			}
-			keyToValue[entry.Key] = value
-		}
-		out, err := json.Marshal(keyToValue)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal secret: %w", err)
-		}
-		return out, nil
+		return marshalEntries(entries)
 	}
 
 	entry, err := findEntryByKey(entries, property)
@@ -82,6 +70,22 @@ func (g *lockboxSecretGetter) GetSecretMap(ctx context.Context, iamToken, resour
 	return secretMap, nil
 }
 
+func marshalEntries(entries []*lockbox.Payload_Entry) ([]byte, error) {
+	keyToValue := make(map[string]interface{}, len(entries))
+	for _, entry := range entries {
+		value, err := getValueAsIs(entry)
+		if err != nil {
+			return nil, err
+		}
+		keyToValue[entry.Key] = value
+	}
+	out, err := json.Marshal(keyToValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal secret: %w", err)
+	}
+	return out, nil
+}
+
 func getValueAsIs(entry *lockbox.Payload_Entry) (interface{}, error) {
 	switch entry.Value.(type) {
 	case *lockbox.Payload_Entry_TextValue:
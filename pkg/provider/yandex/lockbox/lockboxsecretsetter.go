@@ -0,0 +1,264 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lockbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+	"github.com/external-secrets/external-secrets/pkg/provider/yandex/common"
+	"github.com/external-secrets/external-secrets/pkg/provider/yandex/lockbox/client"
+)
+
+const (
+	// secrets created by PushSecrets are labeled, only those are updated or deleted.
+	managedByLabel = "managed-by"
+	managedByValue = "external-secrets"
+
+	errNotManaged = "secret %q is not managed by external-secrets"
+)
+
+// Implementation of common.SecretSetter.
+type lockboxSecretSetter struct {
+	lockboxClient client.LockboxClient
+}
+
+func newLockboxSecretSetter(lockboxClient client.LockboxClient) (common.SecretSetter, error) {
+	return &lockboxSecretSetter{
+		lockboxClient: lockboxClient,
+	}, nil
+}
+
+func (s *lockboxSecretSetter) SetSecret(ctx context.Context, iamToken, folderID, name string, entries map[string][]byte) error {
+	secret, err := s.findSecretByName(ctx, iamToken, folderID, name)
+	if err != nil {
+		return err
+	}
+
+	if secret == nil {
+		labels := map[string]string{managedByLabel: managedByValue}
+		_, err = s.lockboxClient.CreateSecret(ctx, iamToken, folderID, name, labels, toPayloadEntryChanges(entries))
+		if err != nil {
+			return fmt.Errorf("unable to create secret: %w", err)
+		}
+		return nil
+	}
+	if !isManaged(secret) {
+		return fmt.Errorf(errNotManaged, name)
+	}
+
+	current, err := s.getEntryMap(ctx, iamToken, secret.Id)
+	if err != nil {
+		return err
+	}
+	merged := make(map[string][]byte, len(current)+len(entries))
+	for key, value := range current {
+		merged[key] = value
+	}
+	changed := false
+	for key, value := range entries {
+		if currentValue, ok := current[key]; !ok || !bytes.Equal(currentValue, value) {
+			changed = true
+		}
+		merged[key] = value
+	}
+	if !changed {
+		return nil
+	}
+
+	// the whole payload is always written to not depend on the entries of the base version
+	_, err = s.lockboxClient.AddVersion(ctx, iamToken, secret.Id, toPayloadEntryChanges(merged))
+	if err != nil {
+		return fmt.Errorf("unable to add secret version: %w", err)
+	}
+	return nil
+}
+
+func (s *lockboxSecretSetter) DeleteSecret(ctx context.Context, iamToken, folderID, name, property string) error {
+	secret, err := s.findSecretByName(ctx, iamToken, folderID, name)
+	if err != nil || secret == nil {
+		return err
+	}
+	// secrets which have not been created by external-secrets are left alone.
+	if !isManaged(secret) {
+		return nil
+	}
+
+	if property != "" {
+		current, err := s.getEntryMap(ctx, iamToken, secret.Id)
+		if err != nil {
+			return err
+		}
+		if _, ok := current[property]; !ok {
+			return nil
+		}
+		delete(current, property)
+		if len(current) > 0 {
+			_, err = s.lockboxClient.AddVersion(ctx, iamToken, secret.Id, toPayloadEntryChanges(current))
+			if err != nil {
+				return fmt.Errorf("unable to add secret version: %w", err)
+			}
+			return nil
+		}
+	}
+
+	err = s.lockboxClient.DeleteSecret(ctx, iamToken, secret.Id)
+	if err != nil {
+		return fmt.Errorf("unable to delete secret: %w", err)
+	}
+	return nil
+}
+
+func (s *lockboxSecretSetter) SecretExists(ctx context.Context, iamToken, folderID, name, property string) (bool, error) {
+	secret, err := s.findSecretByName(ctx, iamToken, folderID, name)
+	if err != nil || secret == nil {
+		return false, err
+	}
+	if property == "" {
+		return true, nil
+	}
+
+	current, err := s.getEntryMap(ctx, iamToken, secret.Id)
+	if err != nil {
+		return false, err
+	}
+	_, ok := current[property]
+	return ok, nil
+}
+
+func (s *lockboxSecretSetter) GetAllSecrets(ctx context.Context, iamToken, folderID string, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if ref.Path != nil {
+		return nil, fmt.Errorf("find by path is not supported by Yandex Lockbox")
+	}
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		matcher = m
+	}
+
+	secrets, err := s.lockboxClient.ListSecrets(ctx, iamToken, folderID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list secrets: %w", err)
+	}
+
+	secretMap := make(map[string][]byte)
+	for _, secret := range secrets {
+		if secret.Status != lockbox.Secret_ACTIVE {
+			continue
+		}
+		if matcher != nil && !matcher.MatchName(secret.Name) {
+			continue
+		}
+		if !matchLabels(secret.Labels, ref.Tags) {
+			continue
+		}
+
+		entries, err := s.lockboxClient.GetPayloadEntries(ctx, iamToken, secret.Id, "")
+		if err != nil {
+			return nil, fmt.Errorf("unable to request secret payload to find secrets: %w", err)
+		}
+		value, err := marshalEntries(entries)
+		if err != nil {
+			return nil, err
+		}
+		secretMap[secret.Name] = value
+	}
+	return secretMap, nil
+}
+
+func (s *lockboxSecretSetter) FindSecretID(ctx context.Context, iamToken, folderID, name string) (string, error) {
+	secret, err := s.findSecretByName(ctx, iamToken, folderID, name)
+	if err != nil {
+		return "", err
+	}
+	if secret == nil {
+		return "", esv1beta1.NoSecretError{}
+	}
+	return secret.Id, nil
+}
+
+func (s *lockboxSecretSetter) findSecretByName(ctx context.Context, iamToken, folderID, name string) (*lockbox.Secret, error) {
+	secrets, err := s.lockboxClient.ListSecrets(ctx, iamToken, folderID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list secrets: %w", err)
+	}
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return secret, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *lockboxSecretSetter) getEntryMap(ctx context.Context, iamToken, secretID string) (map[string][]byte, error) {
+	entries, err := s.lockboxClient.GetPayloadEntries(ctx, iamToken, secretID, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to request secret payload: %w", err)
+	}
+	entryMap := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		value, err := getValueAsBinary(entry)
+		if err != nil {
+			return nil, err
+		}
+		entryMap[entry.Key] = value
+	}
+	return entryMap, nil
+}
+
+func isManaged(secret *lockbox.Secret) bool {
+	return secret.Labels[managedByLabel] == managedByValue
+}
+
+func matchLabels(labels, tags map[string]string) bool {
+	for key, value := range tags {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Values that are valid UTF-8 are stored as text entries, the others as binary entries.
+func toPayloadEntryChanges(entries map[string][]byte) []*lockbox.PayloadEntryChange {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	changes := make([]*lockbox.PayloadEntryChange, 0, len(entries))
+	for _, key := range keys {
+		value := entries[key]
+		change := &lockbox.PayloadEntryChange{Key: key}
+		if utf8.Valid(value) {
+			change.Value = &lockbox.PayloadEntryChange_TextValue{TextValue: string(value)}
+		} else {
+			change.Value = &lockbox.PayloadEntryChange_BinaryValue{BinaryValue: value}
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
@@ -0,0 +1,251 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cel implements a template engine which evaluates
+// CEL expressions over the secret data.
+package cel
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+	corev1 "k8s.io/api/core/v1"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// dataVar is the variable which holds the secret data.
+	dataVar = "data"
+
+	// costLimit limits the runtime cost of an expression,
+	// it matches the per-expression limit of Kubernetes validation rules.
+	costLimit = 1000000
+
+	errCompile    = "unable to compile expression at key %s: %w"
+	errEvaluate   = "unable to evaluate expression at key %s: %w"
+	errConvert    = "unable to convert result of expression at key %s: %w"
+	errNotMap     = "expression at key %s must return a map, got %s"
+	errMapKey     = "expression at key %s must return a map with string keys, got %s"
+	errUnknownScp = "unknown scope '%v': expected 'Values' or 'KeysAndValues'"
+)
+
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(dataVar, cel.MapType(cel.StringType, cel.StringType)),
+		cel.OptionalTypes(),
+		ext.Strings(),
+		ext.Encoders(),
+		ext.Lists(),
+		ext.Sets(),
+		ext.Math(),
+		serializationLib(),
+	)
+})
+
+// Execute evaluates the templates as CEL expressions. The secret data is
+// available as `data`. If an error occurs processing is stopped immediately.
+func Execute(tpl, data map[string][]byte, scope esapi.TemplateScope, target esapi.TemplateTarget, secret *corev1.Secret) error {
+	if tpl == nil {
+		return nil
+	}
+	strData := make(map[string]string, len(data))
+	for k, v := range data {
+		strData[k] = string(v)
+	}
+	vars := map[string]any{dataVar: strData}
+
+	switch scope {
+	case esapi.TemplateScopeKeysAndValues:
+		for k, v := range tpl {
+			if err := mapScopeApply(k, string(v), vars, target, secret); err != nil {
+				return err
+			}
+		}
+	case esapi.TemplateScopeValues:
+		for k, v := range tpl {
+			if err := valueScopeApply(k, string(v), vars, target, secret); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf(errUnknownScp, scope)
+	}
+	return nil
+}
+
+func valueScopeApply(k, expr string, vars map[string]any, target esapi.TemplateTarget, secret *corev1.Secret) error {
+	val, err := evaluate(k, expr, vars)
+	if err != nil {
+		return err
+	}
+	out, err := toBytes(val)
+	if err != nil {
+		return fmt.Errorf(errConvert, k, err)
+	}
+	applyToTarget(k, out, target, secret)
+	return nil
+}
+
+func mapScopeApply(k, expr string, vars map[string]any, target esapi.TemplateTarget, secret *corev1.Secret) error {
+	val, err := evaluate(k, expr, vars)
+	if err != nil {
+		return err
+	}
+	m, ok := val.(traits.Mapper)
+	if !ok {
+		return fmt.Errorf(errNotMap, k, val.Type().TypeName())
+	}
+	it := m.Iterator()
+	for it.HasNext() == types.True {
+		key := it.Next()
+		strKey, ok := key.(types.String)
+		if !ok {
+			return fmt.Errorf(errMapKey, k, key.Type().TypeName())
+		}
+		out, err := toBytes(m.Get(key))
+		if err != nil {
+			return fmt.Errorf(errConvert, k, err)
+		}
+		applyToTarget(string(strKey), out, target, secret)
+	}
+	return nil
+}
+
+// Validate compiles the expression at the given key without evaluating it.
+func Validate(k, expr string) error {
+	_, err := compile(k, expr)
+	return err
+}
+
+func evaluate(k, expr string, vars map[string]any) (ref.Val, error) {
+	prg, err := compile(k, expr)
+	if err != nil {
+		return nil, err
+	}
+	val, _, err := prg.Eval(vars)
+	if err != nil {
+		return nil, fmt.Errorf(errEvaluate, k, err)
+	}
+	return val, nil
+}
+
+func compile(k, expr string) (cel.Program, error) {
+	env, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf(errCompile, k, iss.Err())
+	}
+	prg, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf(errCompile, k, err)
+	}
+	return prg, nil
+}
+
+func applyToTarget(k string, val []byte, target esapi.TemplateTarget, secret *corev1.Secret) {
+	switch target {
+	case esapi.TemplateTargetAnnotations:
+		secret.Annotations[k] = string(val)
+	case esapi.TemplateTargetLabels:
+		secret.Labels[k] = string(val)
+	case esapi.TemplateTargetData:
+		secret.Data[k] = val
+	default:
+	}
+}
+
+// toBytes returns strings and bytes as they are,
+// all other values are encoded as JSON.
+func toBytes(val ref.Val) ([]byte, error) {
+	switch v := val.(type) {
+	case types.String:
+		return []byte(v), nil
+	case types.Bytes:
+		return []byte(v), nil
+	}
+	native, err := toNative(val)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(native)
+}
+
+// toNative converts a CEL value into its JSON representation.
+func toNative(val ref.Val) (any, error) {
+	if types.IsError(val) {
+		return nil, val.(*types.Err)
+	}
+	pb, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, err
+	}
+	return pb.(*structpb.Value).AsInterface(), nil
+}
+
+// fromNative converts decoded JSON into a CEL value.
+// Integral numbers are returned as int, all other numbers as double.
+func fromNative(v any) ref.Val {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return types.Int(i)
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return types.NewErr("invalid number %q", t)
+		}
+		return types.Double(f)
+	case map[string]any:
+		m := make(map[ref.Val]ref.Val, len(t))
+		for k, v := range t {
+			m[types.String(k)] = fromNative(v)
+		}
+		return types.NewRefValMap(types.DefaultTypeAdapter, m)
+	case []any:
+		l := make([]ref.Val, len(t))
+		for i := range t {
+			l[i] = fromNative(t[i])
+		}
+		return types.NewRefValList(types.DefaultTypeAdapter, l)
+	default:
+		return types.DefaultTypeAdapter.NativeToValue(t)
+	}
+}
+
+func decodeJSON(data []byte) ref.Val {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return types.WrapErr(err)
+	}
+	if dec.More() {
+		return types.WrapErr(errors.New("unexpected data after top-level value"))
+	}
+	return fromNative(v)
+}
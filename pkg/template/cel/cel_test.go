@@ -0,0 +1,171 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestExecute(t *testing.T) {
+	tbl := []struct {
+		name     string
+		tpl      map[string]string
+		data     map[string][]byte
+		scope    esapi.TemplateScope
+		target   esapi.TemplateTarget
+		expected map[string]string
+		expErr   string
+	}{
+		{
+			name:     "string concatenation",
+			tpl:      map[string]string{"url": `"postgres://" + data.user + ":" + data["pass-word"] + "@db"`},
+			data:     map[string][]byte{"user": []byte("app"), "pass-word": []byte("s3cr3t")},
+			expected: map[string]string{"url": "postgres://app:s3cr3t@db"},
+		},
+		{
+			name:     "non-string results are encoded as JSON",
+			tpl:      map[string]string{"count": `size(data)`, "ok": `has(data.user)`, "list": `data.user.split(",")`},
+			data:     map[string][]byte{"user": []byte("a,b")},
+			expected: map[string]string{"count": "1", "ok": "true", "list": `["a","b"]`},
+		},
+		{
+			name:     "bytes results are returned as they are",
+			tpl:      map[string]string{"raw": `base64.decode(data.cert)`},
+			data:     map[string][]byte{"cert": []byte("Zm9vYmFy")},
+			expected: map[string]string{"raw": "foobar"},
+		},
+		{
+			name: "parse and modify JSON",
+			tpl: map[string]string{
+				"host":   `fromJSON(data.config).db.host`,
+				"port":   `string(fromJSON(data.config).db.port + 1)`,
+				"config": `toJSON(fromJSON(data.config).db)`,
+			},
+			data:     map[string][]byte{"config": []byte(`{"db":{"host":"localhost","port":5432}}`)},
+			expected: map[string]string{"host": "localhost", "port": "5433", "config": `{"host":"localhost","port":5432}`},
+		},
+		{
+			name: "parse and render YAML",
+			tpl: map[string]string{
+				"users":  `fromYAML(data.config).users.map(u, u.name).join(",")`,
+				"config": `toYAML({"users": fromYAML(data.config).users.filter(u, u.admin)})`,
+			},
+			data:     map[string][]byte{"config": []byte("users:\n- name: foo\n  admin: true\n- name: bar\n  admin: false\n")},
+			expected: map[string]string{"users": "foo,bar", "config": "users:\n- admin: true\n  name: foo\n"},
+		},
+		{
+			name:     "keys and values",
+			tpl:      map[string]string{"map": `{"user": data.db_user, "port": 5432}`},
+			scope:    esapi.TemplateScopeKeysAndValues,
+			data:     map[string][]byte{"db_user": []byte("app")},
+			expected: map[string]string{"user": "app", "port": "5432"},
+		},
+		{
+			name:     "labels",
+			tpl:      map[string]string{"env": `data.env.lowerAscii()`},
+			target:   esapi.TemplateTargetLabels,
+			data:     map[string][]byte{"env": []byte("PROD")},
+			expected: map[string]string{"env": "prod"},
+		},
+		{
+			name:     "annotations",
+			tpl:      map[string]string{"owner": `data.owner`},
+			target:   esapi.TemplateTargetAnnotations,
+			data:     map[string][]byte{"owner": []byte("team-a")},
+			expected: map[string]string{"owner": "team-a"},
+		},
+		{
+			name:   "keys and values must return a map",
+			tpl:    map[string]string{"map": `"foo"`},
+			scope:  esapi.TemplateScopeKeysAndValues,
+			expErr: "must return a map",
+		},
+		{
+			name:     "optional key",
+			tpl:      map[string]string{"port": `data[?"port"].orValue("5432")`},
+			expected: map[string]string{"port": "5432"},
+		},
+		{
+			name:   "missing key",
+			tpl:    map[string]string{"foo": `data.missing`},
+			expErr: "unable to evaluate expression at key foo: no such key: missing",
+		},
+		{
+			name:   "invalid expression",
+			tpl:    map[string]string{"foo": `data.`},
+			expErr: "unable to compile expression at key foo",
+		},
+		{
+			name:   "invalid JSON",
+			tpl:    map[string]string{"foo": `fromJSON(data.config).foo`},
+			data:   map[string][]byte{"config": []byte(`{`)},
+			expErr: "unable to evaluate expression at key foo",
+		},
+		{
+			name:   "unknown scope",
+			tpl:    map[string]string{"foo": `"bar"`},
+			scope:  "Unknown",
+			expErr: "unknown scope",
+		},
+	}
+
+	for _, row := range tbl {
+		t.Run(row.name, func(t *testing.T) {
+			sec := &corev1.Secret{
+				Data:       make(map[string][]byte),
+				ObjectMeta: metav1.ObjectMeta{Labels: make(map[string]string), Annotations: make(map[string]string)},
+			}
+			tpl := make(map[string][]byte, len(row.tpl))
+			for k, v := range row.tpl {
+				tpl[k] = []byte(v)
+			}
+			scope := row.scope
+			if scope == "" {
+				scope = esapi.TemplateScopeValues
+			}
+			target := row.target
+			if target == "" {
+				target = esapi.TemplateTargetData
+			}
+			err := Execute(tpl, row.data, scope, target, sec)
+			if row.expErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), row.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got := make(map[string]string)
+			switch target {
+			case esapi.TemplateTargetLabels:
+				got = sec.Labels
+			case esapi.TemplateTargetAnnotations:
+				got = sec.Annotations
+			default:
+				for k, v := range sec.Data {
+					got[k] = string(v)
+				}
+			}
+			assert.Equal(t, row.expected, got)
+		})
+	}
+}
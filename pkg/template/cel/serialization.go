@@ -0,0 +1,94 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"encoding/json"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"sigs.k8s.io/yaml"
+)
+
+// serializationLib provides functions to parse and
+// serialize JSON and YAML documents:
+//
+//	fromJSON(string) -> dyn
+//	toJSON(dyn) -> string
+//	fromYAML(string) -> dyn
+//	toYAML(dyn) -> string
+func serializationLib() cel.EnvOption {
+	return cel.Lib(serialization{})
+}
+
+type serialization struct{}
+
+func (serialization) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("fromJSON",
+			cel.Overload("fromJSON_string", []*cel.Type{cel.StringType}, cel.DynType,
+				cel.UnaryBinding(fromJSON))),
+		cel.Function("toJSON",
+			cel.Overload("toJSON_dyn", []*cel.Type{cel.DynType}, cel.StringType,
+				cel.UnaryBinding(toJSON))),
+		cel.Function("fromYAML",
+			cel.Overload("fromYAML_string", []*cel.Type{cel.StringType}, cel.DynType,
+				cel.UnaryBinding(fromYAML))),
+		cel.Function("toYAML",
+			cel.Overload("toYAML_dyn", []*cel.Type{cel.DynType}, cel.StringType,
+				cel.UnaryBinding(toYAML))),
+	}
+}
+
+func (serialization) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+func fromJSON(val ref.Val) ref.Val {
+	return decodeJSON([]byte(val.(types.String)))
+}
+
+func toJSON(val ref.Val) ref.Val {
+	native, err := toNative(val)
+	if err != nil {
+		return types.WrapErr(err)
+	}
+	out, err := json.Marshal(native)
+	if err != nil {
+		return types.WrapErr(err)
+	}
+	return types.String(out)
+}
+
+func fromYAML(val ref.Val) ref.Val {
+	data, err := yaml.YAMLToJSON([]byte(val.(types.String)))
+	if err != nil {
+		return types.WrapErr(err)
+	}
+	return decodeJSON(data)
+}
+
+func toYAML(val ref.Val) ref.Val {
+	native, err := toNative(val)
+	if err != nil {
+		return types.WrapErr(err)
+	}
+	out, err := yaml.Marshal(native)
+	if err != nil {
+		return types.WrapErr(err)
+	}
+	return types.String(out)
+}
@@ -14,9 +14,12 @@ limitations under the License.
 package template
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 
 	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/template/cel"
 	v1 "github.com/external-secrets/external-secrets/pkg/template/v1"
 	v2 "github.com/external-secrets/external-secrets/pkg/template/v2"
 )
@@ -29,9 +32,24 @@ func EngineForVersion(version esapi.TemplateEngineVersion) (ExecFunc, error) {
 		return v1.Execute, nil
 	case esapi.TemplateEngineV2:
 		return v2.Execute, nil
+	case esapi.TemplateEngineCEL:
+		return cel.Execute, nil
 	}
 
 	// in case we run with a old v1alpha1 CRD
 	// we must return v1 as default
 	return v1.Execute, nil
 }
+
+// EngineWithOptions returns the engine for the given version which renders
+// the templates with the given options. Only v2 supports options,
+// other engines ignore the context and fail if a library is used.
+func EngineWithOptions(version esapi.TemplateEngineVersion, opts v2.Options) (ExecFunc, error) {
+	if version == esapi.TemplateEngineV2 {
+		return v2.ExecuteWithOptions(opts), nil
+	}
+	if len(opts.Library) > 0 {
+		return nil, fmt.Errorf("template engine %q does not support secret templates", version)
+	}
+	return EngineForVersion(version)
+}
@@ -87,6 +87,15 @@ func Execute(tpl, data map[string][]byte, _ esapi.TemplateScope, _ esapi.Templat
 	return nil
 }
 
+// Validate parses the template at the given key without executing it.
+func Validate(k, val string) error {
+	_, err := tpl.New(k).Funcs(tplFuncs).Parse(val)
+	if err != nil {
+		return fmt.Errorf(errParse, k, err)
+	}
+	return nil
+}
+
 func execute(k, val string, data map[string][]byte) ([]byte, error) {
 	t, err := tpl.New(k).
 		Funcs(tplFuncs).
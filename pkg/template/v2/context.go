@@ -0,0 +1,125 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	tpl "text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// Context describes the resources a template is rendered for.
+// It is exposed to templates through the externalSecret, targetSecret, remoteRef and remoteMetadata functions.
+type Context struct {
+	// ExternalSecret is the metadata of the ExternalSecret the template belongs to.
+	ExternalSecret *metav1.ObjectMeta
+	// TargetSecret is the target Secret as it exists before the template is rendered.
+	// It is nil if the Secret does not exist yet.
+	TargetSecret *corev1.Secret
+	// RemoteRefs are the references the data keys have been fetched with.
+	RemoteRefs map[string]esapi.ExternalSecretDataRemoteRef
+	// Metadata is the provider metadata of the data keys fetched with MetadataPolicy=Fetch.
+	Metadata map[string]esapi.SecretMetadata
+}
+
+// funcs returns the template functions of the context.
+// They are available without context as well, returning empty values.
+func (c *Context) funcs() tpl.FuncMap {
+	return tpl.FuncMap{
+		"externalSecret": c.externalSecret,
+		"targetSecret":   c.targetSecret,
+		"remoteRef":      c.remoteRef,
+		"remoteMetadata": c.remoteMetadata,
+	}
+}
+
+func (c *Context) externalSecret() map[string]interface{} {
+	var meta metav1.ObjectMeta
+	if c != nil && c.ExternalSecret != nil {
+		meta = *c.ExternalSecret
+	}
+	return objectMetaMap(meta)
+}
+
+func (c *Context) targetSecret() map[string]interface{} {
+	var secret corev1.Secret
+	if c != nil && c.TargetSecret != nil {
+		secret = *c.TargetSecret
+	}
+	m := objectMetaMap(secret.ObjectMeta)
+	data := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+	m["data"] = data
+	return m
+}
+
+func (c *Context) remoteRef(key string) map[string]interface{} {
+	var ref esapi.ExternalSecretDataRemoteRef
+	if c != nil {
+		ref = c.RemoteRefs[key]
+	}
+	return map[string]interface{}{
+		"key":            ref.Key,
+		"property":       ref.Property,
+		"version":        ref.Version,
+		"metadataPolicy": string(ref.MetadataPolicy),
+	}
+}
+
+func (c *Context) remoteMetadata(key string) map[string]interface{} {
+	var metadata esapi.SecretMetadata
+	if c != nil {
+		metadata = c.Metadata[key]
+	}
+	tags := metadata.Tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	createdTime := ""
+	if !metadata.CreatedTime.IsZero() {
+		createdTime = metadata.CreatedTime.UTC().Format(time.RFC3339)
+	}
+	return map[string]interface{}{
+		"path":        metadata.Path,
+		"tags":        tags,
+		"version":     metadata.Version,
+		"createdTime": createdTime,
+	}
+}
+
+// objectMetaMap returns the fields of the metadata that are exposed to templates.
+// Labels and annotations are never nil, so they can be indexed safely.
+func objectMetaMap(meta metav1.ObjectMeta) map[string]interface{} {
+	labels := meta.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	annotations := meta.Annotations
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	return map[string]interface{}{
+		"name":        meta.Name,
+		"namespace":   meta.Namespace,
+		"labels":      labels,
+		"annotations": annotations,
+	}
+}
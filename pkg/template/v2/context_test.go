@@ -0,0 +1,96 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestExecuteWithContext(t *testing.T) {
+	tplCtx := &Context{
+		ExternalSecret: &metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "team-a",
+			Labels:    map[string]string{"app": "api"},
+		},
+		TargetSecret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "team-a"},
+			Data:       map[string][]byte{"salt": []byte("existing")},
+		},
+		RemoteRefs: map[string]esapi.ExternalSecretDataRemoteRef{
+			"password": {Key: "db/password", Version: "3"},
+		},
+		Metadata: map[string]esapi.SecretMetadata{
+			"user": {
+				Path:        "db/user",
+				Version:     "7",
+				CreatedTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+		},
+	}
+	tpl := map[string][]byte{
+		"dsn":     []byte(`postgres://{{ .user }}@db.{{ (externalSecret).namespace }}.svc/{{ index (externalSecret).labels "app" }}`),
+		"salt":    []byte(`{{ index (targetSecret).data "salt" | default "generated" }}`),
+		"target":  []byte(`{{ (targetSecret).name }}`),
+		"version": []byte(`{{ (remoteRef "password").key }}@{{ (remoteRef "password").version }}`),
+		"created": []byte(`{{ (remoteMetadata "user").version }}@{{ (remoteMetadata "user").createdTime }}`),
+	}
+	data := map[string][]byte{"user": []byte("admin")}
+
+	tbl := []struct {
+		name    string
+		context *Context
+		want    map[string][]byte
+	}{
+		{
+			name:    "with context",
+			context: tplCtx,
+			want: map[string][]byte{
+				"dsn":     []byte("postgres://admin@db.team-a.svc/api"),
+				"salt":    []byte("existing"),
+				"target":  []byte("db-credentials"),
+				"version": []byte("db/password@3"),
+				"created": []byte("7@2024-01-02T03:04:05Z"),
+			},
+		},
+		{
+			name: "without context",
+			want: map[string][]byte{
+				"dsn":     []byte("postgres://admin@db..svc/"),
+				"salt":    []byte("generated"),
+				"target":  []byte(""),
+				"version": []byte("@"),
+				"created": []byte("@"),
+			},
+		},
+	}
+	for i := range tbl {
+		row := tbl[i]
+		t.Run(row.name, func(t *testing.T) {
+			sec := &corev1.Secret{Data: make(map[string][]byte)}
+			err := ExecuteWithOptions(Options{Context: row.context})(tpl, data, esapi.TemplateScopeValues, esapi.TemplateTargetData, sec)
+			require.NoError(t, err)
+			assert.Equal(t, row.want, sec.Data)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Password hashes use a random salt. To keep the secret stable between reconciles,
+// a previous hash, e.g. read from the target Secret, is returned unchanged
+// as long as it still matches the password and the parameters.
+const (
+	bcryptCost = 10
+
+	argon2Time    = 2
+	argon2Memory  = 19 * 1024
+	argon2Threads = 1
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+
+	scryptLogN    = 15
+	scryptR       = 8
+	scryptP       = 1
+	scryptKeyLen  = 32
+	scryptSaltLen = 16
+
+	errPreviousHash = "expected at most one previous hash, got %d"
+)
+
+// bcryptHash returns the bcrypt hash of the password in the modular crypt format,
+// as used in htpasswd files.
+func bcryptHash(password string, previous ...string) (string, error) {
+	prev, err := previousHash(previous)
+	if err != nil {
+		return "", err
+	}
+	if prev != "" {
+		cost, err := bcrypt.Cost([]byte(prev))
+		if err == nil && cost == bcryptCost && bcrypt.CompareHashAndPassword([]byte(prev), []byte(password)) == nil {
+			return prev, nil
+		}
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// argon2idHash returns the argon2id hash of the password in the PHC string format.
+func argon2idHash(password string, previous ...string) (string, error) {
+	hash := func(salt []byte) (string, error) {
+		key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s", argon2.Version, argon2Memory, argon2Time, argon2Threads, phcValue(salt, key)), nil
+	}
+	return phcHash(hash, argon2SaltLen, previous)
+}
+
+// scryptHash returns the scrypt hash of the password in the PHC string format.
+func scryptHash(password string, previous ...string) (string, error) {
+	hash := func(salt []byte) (string, error) {
+		key, err := scrypt.Key([]byte(password), salt, 1<<scryptLogN, scryptR, scryptP, scryptKeyLen)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s", scryptLogN, scryptR, scryptP, phcValue(salt, key)), nil
+	}
+	return phcHash(hash, scryptSaltLen, previous)
+}
+
+// phcHash returns the previous PHC string if hashing the password with its salt
+// results in the same string, i.e. the password and the parameters did not change.
+// Otherwise the password is hashed with a new random salt.
+func phcHash(hash func(salt []byte) (string, error), saltLen int, previous []string) (string, error) {
+	prev, err := previousHash(previous)
+	if err != nil {
+		return "", err
+	}
+	if salt, ok := phcSalt(prev); ok {
+		out, err := hash(salt)
+		if err != nil {
+			return "", err
+		}
+		if subtle.ConstantTimeCompare([]byte(out), []byte(prev)) == 1 {
+			return prev, nil
+		}
+	}
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hash(salt)
+}
+
+// phcSalt returns the salt of a PHC string, which is the second to last field.
+func phcSalt(phc string) ([]byte, bool) {
+	fields := strings.Split(phc, "$")
+	if len(fields) < 4 {
+		return nil, false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(fields[len(fields)-2])
+	if err != nil || len(salt) == 0 {
+		return nil, false
+	}
+	return salt, true
+}
+
+func phcValue(salt, key []byte) string {
+	return base64.RawStdEncoding.EncodeToString(salt) + "$" + base64.RawStdEncoding.EncodeToString(key)
+}
+
+func previousHash(previous []string) (string, error) {
+	switch len(previous) {
+	case 0:
+		return "", nil
+	case 1:
+		return previous[0], nil
+	default:
+		return "", fmt.Errorf(errPreviousHash, len(previous))
+	}
+}
+
+func hmacSum(h func() hash.Hash, key, data string) string {
+	mac := hmac.New(h, []byte(key))
+	mac.Write([]byte(data))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// hmacSHA256 returns the hex encoded HMAC-SHA256 of data.
+func hmacSHA256(key, data string) string {
+	return hmacSum(sha256.New, key, data)
+}
+
+// hmacSHA384 returns the hex encoded HMAC-SHA384 of data.
+func hmacSHA384(key, data string) string {
+	return hmacSum(sha512.New384, key, data)
+}
+
+// hmacSHA512 returns the hex encoded HMAC-SHA512 of data.
+func hmacSHA512(key, data string) string {
+	return hmacSum(sha512.New, key, data)
+}
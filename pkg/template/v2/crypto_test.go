@@ -0,0 +1,241 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	agearmor "filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgparmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+	corev1 "k8s.io/api/core/v1"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestBcryptHash(t *testing.T) {
+	hash, err := bcryptHash("password")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$2a$10$"))
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("password")))
+	assert.Error(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("other")))
+
+	// the salt is random, a matching previous hash is kept.
+	other, err := bcryptHash("password")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+	again, err := bcryptHash("password", hash)
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	// a previous hash of another password or cost is replaced.
+	changed, err := bcryptHash("changed", hash)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(changed), []byte("changed")))
+	lowCost, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	upgraded, err := bcryptHash("password", string(lowCost))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(upgraded, "$2a$10$"))
+	invalid, err := bcryptHash("password", "invalid")
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(invalid), []byte("password")))
+
+	_, err = bcryptHash(strings.Repeat("a", 73))
+	assert.Error(t, err)
+	_, err = bcryptHash("password", "a", "b")
+	assert.ErrorContains(t, err, "expected at most one previous hash")
+}
+
+func TestArgon2idHash(t *testing.T) {
+	hash, err := argon2idHash("password")
+	require.NoError(t, err)
+	parts := strings.Split(hash, "$")
+	require.Len(t, parts, 6)
+	assert.Equal(t, "argon2id", parts[1])
+	assert.Equal(t, "v=19", parts[2])
+	assert.Equal(t, "m=19456,t=2,p=1", parts[3])
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	require.NoError(t, err)
+	assert.Len(t, salt, 16)
+	key := argon2.IDKey([]byte("password"), salt, 2, 19456, 1, 32)
+	assert.Equal(t, base64.RawStdEncoding.EncodeToString(key), parts[5])
+
+	other, err := argon2idHash("password")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+	again, err := argon2idHash("password", hash)
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	changed, err := argon2idHash("changed", hash)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+	// other parameters result in a new hash.
+	weak := strings.Replace(hash, "t=2", "t=1", 1)
+	upgraded, err := argon2idHash("password", weak)
+	require.NoError(t, err)
+	assert.NotEqual(t, weak, upgraded)
+	assert.Contains(t, upgraded, "m=19456,t=2,p=1")
+}
+
+func TestScryptHash(t *testing.T) {
+	hash, err := scryptHash("password")
+	require.NoError(t, err)
+	parts := strings.Split(hash, "$")
+	require.Len(t, parts, 5)
+	assert.Equal(t, "scrypt", parts[1])
+	assert.Equal(t, "ln=15,r=8,p=1", parts[2])
+	salt, err := base64.RawStdEncoding.DecodeString(parts[3])
+	require.NoError(t, err)
+	key, err := scrypt.Key([]byte("password"), salt, 1<<15, 8, 1, 32)
+	require.NoError(t, err)
+	assert.Equal(t, base64.RawStdEncoding.EncodeToString(key), parts[4])
+
+	again, err := scryptHash("password", hash)
+	require.NoError(t, err)
+	assert.Equal(t, hash, again)
+	changed, err := scryptHash("changed", hash)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+}
+
+func TestHMAC(t *testing.T) {
+	// RFC 4231 test case 2
+	const key = "Jefe"
+	const data = "what do ya want for nothing?"
+	assert.Equal(t, "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843", hmacSHA256(key, data))
+	assert.Equal(t, "af45d2e376484031617f78d2b58a6b1b9c7ef464f5a01b47e42ec3736322445e8e2240ca5e69e2c78b3239ecfab21649", hmacSHA384(key, data))
+	assert.Equal(t, "164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd610270cd7ea2505549758bf75c05a994a6d034f65f8f0e6fdcaeab1a34d4a6b4b636e070a38bce737", hmacSHA512(key, data))
+}
+
+func TestEncryptionDigest(t *testing.T) {
+	digest, err := encryptionDigest("recipient", "secret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(digest, "$argon2id$"))
+	assert.NotContains(t, digest, "secret")
+
+	again, err := encryptionDigest("recipient", "secret", digest)
+	require.NoError(t, err)
+	assert.Equal(t, digest, again)
+	changed, err := encryptionDigest("other", "secret", digest)
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, changed)
+}
+
+func TestAgeEncrypt(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	recipients := "# comment\n" + identity.Recipient().String() + "\n"
+
+	out, err := ageEncrypt(recipients, "secret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(out, agearmor.Header))
+	r, err := age.Decrypt(agearmor.NewReader(strings.NewReader(out)), identity)
+	require.NoError(t, err)
+	plaintext, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(plaintext))
+
+	// the previous ciphertext is kept while its digest matches
+	digest, err := encryptionDigest(recipients, "secret")
+	require.NoError(t, err)
+	again, err := ageEncrypt(recipients, "secret", out, digest)
+	require.NoError(t, err)
+	assert.Equal(t, out, again)
+	changed, err := ageEncrypt(recipients, "changed", out, digest)
+	require.NoError(t, err)
+	assert.NotEqual(t, out, changed)
+	changed, err = ageEncrypt(recipients, "secret", out, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, out, changed)
+	_, err = ageEncrypt(recipients, "secret", out)
+	assert.ErrorContains(t, err, "expected a previous ciphertext and its digest")
+
+	_, err = ageEncrypt("# no recipients", "secret")
+	assert.ErrorContains(t, err, "no recipients found")
+	_, err = ageEncrypt("invalid", "secret")
+	assert.Error(t, err)
+}
+
+func TestPGPEncrypt(t *testing.T) {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	var pub bytes.Buffer
+	w, err := pgparmor.Encode(&pub, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	out, err := pgpEncrypt(pub.String(), "secret")
+	require.NoError(t, err)
+	block, err := pgparmor.Decode(strings.NewReader(out))
+	require.NoError(t, err)
+	assert.Equal(t, "PGP MESSAGE", block.Type)
+	md, err := openpgp.ReadMessage(block.Body, openpgp.EntityList{entity}, nil, nil)
+	require.NoError(t, err)
+	plaintext, err := io.ReadAll(md.UnverifiedBody)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(plaintext))
+
+	digest, err := encryptionDigest(pub.String(), "secret")
+	require.NoError(t, err)
+	again, err := pgpEncrypt(pub.String(), "secret", out, digest)
+	require.NoError(t, err)
+	assert.Equal(t, out, again)
+	changed, err := pgpEncrypt(pub.String()+"\n", "secret", out, digest)
+	require.NoError(t, err)
+	assert.NotEqual(t, out, changed)
+
+	_, err = pgpEncrypt("invalid", "secret")
+	assert.Error(t, err)
+}
+
+func TestCryptoFuncsInTemplate(t *testing.T) {
+	data := map[string][]byte{
+		"user":     []byte("admin"),
+		"password": []byte("password"),
+	}
+	tpl := map[string][]byte{
+		"auth":      []byte(`{{ .user }}:{{ bcryptHash .password (index (targetSecret).data "auth" | splitList ":" | last) }}`),
+		"signature": []byte(`{{ .user | hmacSHA256 .password }}`),
+	}
+	render := func(target *corev1.Secret) *corev1.Secret {
+		sec := &corev1.Secret{Data: make(map[string][]byte)}
+		err := ExecuteWithOptions(Options{Context: &Context{TargetSecret: target}})(tpl, data, esapi.TemplateScopeValues, esapi.TemplateTargetData, sec)
+		require.NoError(t, err)
+		return sec
+	}
+	sec := render(nil)
+	user, hash, ok := strings.Cut(string(sec.Data["auth"]), ":")
+	require.True(t, ok)
+	assert.Equal(t, "admin", user)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("password")))
+	assert.Equal(t, hmacSHA256("password", "admin"), string(sec.Data["signature"]))
+
+	// the rendered secret must not change between reconciles
+	assert.Equal(t, sec.Data, render(sec).Data)
+}
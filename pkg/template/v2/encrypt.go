@@ -0,0 +1,133 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"filippo.io/age"
+	agearmor "filippo.io/age/armor"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgparmor "github.com/ProtonMail/go-crypto/openpgp/armor"
+)
+
+// Encryption is randomized, so every call returns a new ciphertext.
+// To keep the secret stable between reconciles, a previous ciphertext and its digest,
+// e.g. read from the target Secret, are passed along. The previous ciphertext is
+// returned unchanged as long as the digest still matches the recipients and the data.
+// The digest is an argon2id hash, so it does not reveal the data.
+const (
+	errNoAgeRecipients     = "unable to encrypt with age: no recipients found"
+	errNoPGPKeys           = "unable to encrypt with pgp: no public keys found"
+	errPreviousCiphertext  = "expected a previous ciphertext and its digest, got %d arguments"
+	encryptionDigestFormat = "%s\x00%s"
+)
+
+// encryptionDigest returns the digest of the recipients and the data
+// to be stored alongside the ciphertext. The previous digest is kept
+// as long as the recipients and the data do not change.
+func encryptionDigest(recipients, data string, previous ...string) (string, error) {
+	return argon2idHash(fmt.Sprintf(encryptionDigestFormat, recipients, data), previous...)
+}
+
+// previousCiphertext returns the previous ciphertext
+// if its digest matches the recipients and the data.
+func previousCiphertext(recipients, data string, previous []string) (string, bool, error) {
+	switch len(previous) {
+	case 0:
+		return "", false, nil
+	case 2:
+	default:
+		return "", false, fmt.Errorf(errPreviousCiphertext, len(previous))
+	}
+	ciphertext, digest := previous[0], previous[1]
+	if ciphertext == "" || digest == "" {
+		return "", false, nil
+	}
+	current, err := encryptionDigest(recipients, data, digest)
+	if err != nil {
+		return "", false, err
+	}
+	return ciphertext, current == digest, nil
+}
+
+// ageEncrypt encrypts data to the age recipients, one per line,
+// and returns the ASCII armored ciphertext.
+func ageEncrypt(recipients, data string, previous ...string) (string, error) {
+	if ciphertext, ok, err := previousCiphertext(recipients, data, previous); err != nil || ok {
+		return ciphertext, err
+	}
+	rcpts, err := age.ParseRecipients(strings.NewReader(recipients))
+	if err != nil {
+		return "", err
+	}
+	if len(rcpts) == 0 {
+		return "", errors.New(errNoAgeRecipients)
+	}
+	var buf bytes.Buffer
+	aw := agearmor.NewWriter(&buf)
+	w, err := age.Encrypt(aw, rcpts...)
+	if err != nil {
+		return "", err
+	}
+	if err := writeAndClose(w, data, aw); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// pgpEncrypt encrypts data to the ASCII armored OpenPGP public keys
+// and returns the ASCII armored message.
+func pgpEncrypt(publicKeys, data string, previous ...string) (string, error) {
+	if message, ok, err := previousCiphertext(publicKeys, data, previous); err != nil || ok {
+		return message, err
+	}
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(publicKeys))
+	if err != nil {
+		return "", err
+	}
+	if len(entities) == 0 {
+		return "", errors.New(errNoPGPKeys)
+	}
+	var buf bytes.Buffer
+	aw, err := pgparmor.Encode(&buf, "PGP MESSAGE", nil)
+	if err != nil {
+		return "", err
+	}
+	w, err := openpgp.Encrypt(aw, entities, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if err := writeAndClose(w, data, aw); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// writeAndClose writes data to the encrypting writer
+// and closes it before the armor writer it writes to.
+func writeAndClose(w io.WriteCloser, data string, armor io.Closer) error {
+	if _, err := io.WriteString(w, data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return armor.Close()
+}
@@ -0,0 +1,172 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	errEnvKey          = "invalid env variable name %q"
+	errEnvLine         = "unable to parse env line %d: expected KEY=value"
+	errEnvUnterminated = "unable to parse env line %d: unterminated quoted value"
+	errEnvTrailing     = "unable to parse env line %d: unexpected characters after quoted value"
+)
+
+var (
+	envKeyRegex      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+	envUnquotedRegex = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,-]*$`)
+	envEscaper       = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "\n", `\n`, "\r", `\r`)
+)
+
+// toEnv renders a map as dotenv file with one KEY=value line per key.
+// Values that contain anything but a safe set of characters are double quoted,
+// so they survive quotes, `=`, `#`, `$` and newlines.
+func toEnv(v interface{}) (string, error) {
+	m, err := toMap(v)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, k := range sortedKeys(m) {
+		if !envKeyRegex.MatchString(k) {
+			return "", fmt.Errorf(errEnvKey, k)
+		}
+		val, err := scalarString(k, m[k])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		if envUnquotedRegex.MatchString(val) {
+			b.WriteString(val)
+		} else {
+			b.WriteString(`"` + envEscaper.Replace(val) + `"`)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
+// fromEnv parses a dotenv file into a map.
+// It supports comments, `export` prefixes, unquoted values with inline comments,
+// single quoted literal values and double quoted values with escape sequences.
+// Variables are not expanded.
+func fromEnv(str string) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	lines := strings.Split(strings.ReplaceAll(str, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, rest, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok {
+			return nil, fmt.Errorf(errEnvLine, lineNo)
+		}
+		if !envKeyRegex.MatchString(key) {
+			return nil, fmt.Errorf(errEnvKey, key)
+		}
+		rest = strings.TrimLeft(rest, " \t")
+		if rest == "" || (rest[0] != '"' && rest[0] != '\'') {
+			m[key] = unquotedEnvValue(rest)
+			continue
+		}
+
+		// quoted values may span multiple lines
+		quote := rest[0]
+		rest = rest[1:]
+		var val strings.Builder
+		for {
+			end := envQuoteEnd(rest, quote)
+			if end >= 0 {
+				if quote == '"' {
+					val.WriteString(unescapeEnv(rest[:end]))
+				} else {
+					val.WriteString(rest[:end])
+				}
+				trailing := strings.TrimSpace(rest[end+1:])
+				if trailing != "" && !strings.HasPrefix(trailing, "#") {
+					return nil, fmt.Errorf(errEnvTrailing, lineNo)
+				}
+				break
+			}
+			i++
+			if i >= len(lines) {
+				return nil, fmt.Errorf(errEnvUnterminated, lineNo)
+			}
+			rest += "\n" + lines[i]
+		}
+		m[key] = val.String()
+	}
+	return m, nil
+}
+
+// envQuoteEnd returns the index of the closing quote or -1.
+func envQuoteEnd(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote == '"':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+func unescapeEnv(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case '\\', '"', '$':
+			b.WriteByte(s[i])
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// unquotedEnvValue strips inline comments, which must be preceded by whitespace.
+func unquotedEnvValue(s string) string {
+	for i := 1; i < len(s); i++ {
+		if s[i] == '#' && (s[i-1] == ' ' || s[i-1] == '\t') {
+			s = s[:i]
+			break
+		}
+	}
+	if strings.HasPrefix(s, "#") {
+		return ""
+	}
+	return strings.TrimSpace(s)
+}
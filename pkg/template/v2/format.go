@@ -0,0 +1,100 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+const (
+	errFormatMap    = "expected a map with string keys, got %T"
+	errFormatNested = "unable to render key %q: nested values are not supported"
+)
+
+// toMap converts maps with string keys, like the secret data of a template,
+// to a map[string]interface{}.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf(errFormatMap, v)
+	}
+	m := make(map[string]interface{}, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		val := iter.Value().Interface()
+		if b, ok := val.([]byte); ok {
+			val = string(b)
+		}
+		m[iter.Key().String()] = val
+	}
+	return m, nil
+}
+
+// scalarString returns the string representation of a scalar value.
+func scalarString(key string, v interface{}) (string, error) {
+	switch val := normalizeNumbers(v).(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case []byte:
+		return string(val), nil
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		return "", fmt.Errorf(errFormatNested, key)
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// normalizeNumbers converts integral floats, e.g. numbers decoded from JSON,
+// to integers, so they are not rendered as floats.
+func normalizeNumbers(v interface{}) interface{} {
+	switch val := v.(type) {
+	case float64:
+		if val == math.Trunc(val) && math.Abs(val) < 1e15 {
+			return int64(val)
+		}
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			m[k] = normalizeNumbers(item)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(val))
+		for i, item := range val {
+			s[i] = normalizeNumbers(item)
+		}
+		return s
+	}
+	return v
+}
@@ -0,0 +1,226 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// values that break hand-written templates.
+var formatTestValues = map[string]interface{}{
+	"plain":     "foo",
+	"empty":     "",
+	"spaces":    "  leading and trailing  ",
+	"equals":    "a=b:c",
+	"quotes":    `say "hi" it's me`,
+	"comment":   "foo #bar; baz",
+	"dollar":    "pa$$word",
+	"backslash": `C:\path\`,
+	"multiline": "line1\nline2\n",
+	"unicode":   "grüße 🔑",
+	"number":    float64(8080),
+	"bool":      true,
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	want := make(map[string]interface{})
+	for k, v := range formatTestValues {
+		want[k] = v
+	}
+	want["number"] = "8080"
+	want["bool"] = "true"
+
+	tbl := []struct {
+		name   string
+		render func(interface{}) (string, error)
+		parse  func(string) (map[string]interface{}, error)
+	}{
+		{name: "env", render: toEnv, parse: fromEnv},
+		{name: "properties", render: toProperties, parse: fromProperties},
+		{name: "ini", render: toIni, parse: fromIni},
+	}
+	for i := range tbl {
+		row := tbl[i]
+		t.Run(row.name, func(t *testing.T) {
+			out, err := row.render(formatTestValues)
+			require.NoError(t, err)
+			got, err := row.parse(out)
+			require.NoError(t, err, out)
+			assert.Equal(t, want, got, out)
+		})
+	}
+
+	t.Run("toml", func(t *testing.T) {
+		out, err := toToml(formatTestValues)
+		require.NoError(t, err)
+		got, err := fromToml(out)
+		require.NoError(t, err, out)
+		want["number"] = int64(8080)
+		want["bool"] = true
+		assert.Equal(t, want, got, out)
+	})
+}
+
+func TestToEnv(t *testing.T) {
+	out, err := toEnv(map[string]string{
+		"USER":     "admin",
+		"PASSWORD": "p@ss word\"=$HOME",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "PASSWORD=\"p@ss word\\\"=\\$HOME\"\nUSER=admin\n", out)
+
+	_, err = toEnv(map[string]string{"1INVALID": "foo"})
+	assert.ErrorContains(t, err, `invalid env variable name "1INVALID"`)
+
+	_, err = toEnv(map[string]interface{}{"NESTED": map[string]interface{}{"foo": "bar"}})
+	assert.ErrorContains(t, err, `unable to render key "NESTED": nested values are not supported`)
+
+	_, err = toEnv("foo")
+	assert.ErrorContains(t, err, "expected a map with string keys")
+}
+
+func TestFromEnv(t *testing.T) {
+	in := `# comment
+export FOO=bar
+BAZ = qux # inline comment
+HASH=a#b
+SINGLE='literal \n $HOME' # comment
+DOUBLE="escaped \"quote\"\nnewline"
+MULTI="first
+second"
+EMPTY=
+`
+	got, err := fromEnv(in)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"FOO":    "bar",
+		"BAZ":    "qux",
+		"HASH":   "a#b",
+		"SINGLE": `literal \n $HOME`,
+		"DOUBLE": "escaped \"quote\"\nnewline",
+		"MULTI":  "first\nsecond",
+		"EMPTY":  "",
+	}, got)
+
+	_, err = fromEnv("FOO")
+	assert.ErrorContains(t, err, "unable to parse env line 1")
+	_, err = fromEnv("FOO=\"bar")
+	assert.ErrorContains(t, err, "unterminated quoted value")
+	_, err = fromEnv("FOO=\"bar\" baz")
+	assert.ErrorContains(t, err, "unexpected characters after quoted value")
+}
+
+func TestProperties(t *testing.T) {
+	out, err := toProperties(map[string]string{
+		"key with spaces": " value",
+		"url":             "jdbc:postgresql://db:5432/app?ssl=true",
+		"greeting":        "grüße",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "greeting=gr\\u00FC\\u00DFe\n"+
+		"key\\ with\\ spaces=\\ value\n"+
+		"url=jdbc\\:postgresql\\://db\\:5432/app?ssl\\=true\n", out)
+
+	in := `# comment
+! another comment
+foo = bar
+baz: qux
+separated by space
+multi = first, \
+        second
+escaped\=key = tab\there
+unicode = \u00FC
+`
+	got, err := fromProperties(in)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"foo":         "bar",
+		"baz":         "qux",
+		"separated":   "by space",
+		"multi":       "first, second",
+		"escaped=key": "tab\there",
+		"unicode":     "ü",
+	}, got)
+
+	_, err = fromProperties(`foo=\u00`)
+	assert.ErrorContains(t, err, `unable to parse properties line 1: malformed \uXXXX encoding`)
+}
+
+func TestIni(t *testing.T) {
+	out, err := toIni(map[string]interface{}{
+		"global": "value",
+		"database": map[string]interface{}{
+			"host": "db",
+			"port": float64(5432),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "global = value\n\n[database]\nhost = db\nport = 5432\n", out)
+
+	_, err = toIni(map[string]interface{}{"a=b": "foo"})
+	assert.ErrorContains(t, err, `invalid ini key "a=b"`)
+	_, err = toIni(map[string]interface{}{"multi": "a\nb\""})
+	assert.ErrorContains(t, err, `unable to render ini key "multi"`)
+}
+
+func TestFormatScope(t *testing.T) {
+	data := map[string][]byte{
+		"user":     []byte("admin"),
+		"password": []byte(`p"a=s#s`),
+	}
+	tpl := `
+DB_USER: "{{ .user }}"
+DB_PASSWORD: {{ .password | quote }}
+DB_PORT: 5432
+`
+	tbl := []struct {
+		scope esapi.TemplateScope
+		want  string
+	}{
+		{
+			scope: esapi.TemplateScopeEnv,
+			want:  "DB_PASSWORD=\"p\\\"a=s#s\"\nDB_PORT=5432\nDB_USER=admin\n",
+		},
+		{
+			scope: esapi.TemplateScopeProperties,
+			want:  "DB_PASSWORD=p\"a\\=s\\#s\nDB_PORT=5432\nDB_USER=admin\n",
+		},
+		{
+			scope: esapi.TemplateScopeINI,
+			want:  "DB_PASSWORD = \"p\\\"a=s#s\"\nDB_PORT = 5432\nDB_USER = admin\n",
+		},
+		{
+			scope: esapi.TemplateScopeTOML,
+			want:  "DB_PASSWORD = \"p\\\"a=s#s\"\nDB_PORT = 5432\nDB_USER = \"admin\"\n",
+		},
+	}
+	for i := range tbl {
+		row := tbl[i]
+		t.Run(string(row.scope), func(t *testing.T) {
+			sec := &corev1.Secret{
+				Data: make(map[string][]byte),
+			}
+			err := Execute(map[string][]byte{"config": []byte(tpl)}, data, row.scope, esapi.TemplateTargetData, sec)
+			require.NoError(t, err)
+			assert.Equal(t, row.want, string(sec.Data["config"]))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+const (
+	errIniKey     = "invalid ini key %q"
+	errIniSection = "invalid ini section %q"
+	errIniValue   = "unable to render ini key %q: value can not be quoted"
+)
+
+var (
+	iniKeyRegex     = regexp.MustCompile(`^[^\s=:\[\]#;"'\x60][^\n\r=:]*$`)
+	iniSectionRegex = regexp.MustCompile(`^[^\n\r\[\]]+$`)
+	iniPlainRegex   = regexp.MustCompile(`^[^\s"'\x60#;\\]([^\n\r"'\x60#;\\]*[^\s"'\x60#;\\])?$`)
+)
+
+// toIni renders a map as INI file. Nested maps are rendered as sections,
+// all other keys are written before the first section.
+// Values are quoted if necessary: single line values with double quotes,
+// multiline values with triple quotes.
+func toIni(v interface{}) (string, error) {
+	m, err := toMap(v)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	var sections []string
+	for _, k := range sortedKeys(m) {
+		if _, err := toMap(m[k]); err == nil {
+			sections = append(sections, k)
+			continue
+		}
+		if err := writeIniKey(&b, k, m[k]); err != nil {
+			return "", err
+		}
+	}
+	for _, name := range sections {
+		if !iniSectionRegex.MatchString(name) || strings.TrimSpace(name) != name {
+			return "", fmt.Errorf(errIniSection, name)
+		}
+		section, _ := toMap(m[name])
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString("[" + name + "]\n")
+		for _, k := range sortedKeys(section) {
+			if err := writeIniKey(&b, k, section[k]); err != nil {
+				return "", err
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+func writeIniKey(b *strings.Builder, key string, v interface{}) error {
+	if !iniKeyRegex.MatchString(key) || strings.TrimSpace(key) != key {
+		return fmt.Errorf(errIniKey, key)
+	}
+	val, err := scalarString(key, v)
+	if err != nil {
+		return err
+	}
+	switch {
+	case val == "" || iniPlainRegex.MatchString(val):
+	case !strings.ContainsAny(val, "\n\r"):
+		val = `"` + strings.ReplaceAll(val, `"`, `\"`) + `"`
+	case !strings.Contains(val, `"""`) && !strings.HasSuffix(val, `"`):
+		val = `"""` + val + `"""`
+	default:
+		return fmt.Errorf(errIniValue, key)
+	}
+	b.WriteString(key + " = " + val + "\n")
+	return nil
+}
+
+// fromIni parses an INI file into a map. Keys of the default section
+// are returned at the top level, all other sections as nested maps.
+func fromIni(str string) (map[string]interface{}, error) {
+	f, err := ini.LoadSources(ini.LoadOptions{
+		UnescapeValueDoubleQuotes: true,
+	}, []byte(str))
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]interface{})
+	for _, section := range f.Sections() {
+		keys := make(map[string]interface{})
+		for k, v := range section.KeysHash() {
+			keys[k] = v
+		}
+		if section.Name() == ini.DefaultSection {
+			for k, v := range keys {
+				m[k] = v
+			}
+			continue
+		}
+		m[section.Name()] = keys
+	}
+	return m, nil
+}
@@ -25,7 +25,8 @@ const (
 	errJunk = "error filtering pem: found junk"
 )
 
-func filterPEM(pemType, input string) (string, error) {
+// FilterPEM returns all PEM blocks of the given type found in input.
+func FilterPEM(pemType, input string) (string, error) {
 	data := []byte(input)
 	var blocks []byte
 	var block *pem.Block
@@ -168,13 +168,13 @@ func TestFilterPEM(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := filterPEM(tt.args.pemType, tt.args.input)
+			got, err := FilterPEM(tt.args.pemType, tt.args.input)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("filterPEM() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("FilterPEM() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if got != tt.want {
-				t.Errorf("filterPEM() = %v, want %v", got, tt.want)
+				t.Errorf("FilterPEM() = %v, want %v", got, tt.want)
 			}
 		})
 	}
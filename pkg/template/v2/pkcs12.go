@@ -38,7 +38,7 @@ func pkcs12keyPass(pass, input string) (string, error) {
 		if block.Type == pemTypeCertificate {
 			continue
 		}
-		key, err := parsePrivateKey(block.Bytes)
+		key, err := ParsePrivateKey(block.Bytes)
 		if err != nil {
 			return "", err
 		}
@@ -58,7 +58,8 @@ func pkcs12keyPass(pass, input string) (string, error) {
 	return string(pemData), nil
 }
 
-func parsePrivateKey(block []byte) (interface{}, error) {
+// ParsePrivateKey parses a DER encoded PKCS#1, PKCS#8 or EC private key.
+func ParsePrivateKey(block []byte) (interface{}, error) {
 	if k, err := x509.ParsePKCS1PrivateKey(block); err == nil {
 		return k, nil
 	}
@@ -124,15 +125,21 @@ func pemToPkcs12Pass(cert, key, pass string) (string, error) {
 		return "", err
 	}
 
-	parsedKey, err := parsePrivateKey(keyPem.Bytes)
+	parsedKey, err := ParsePrivateKey(keyPem.Bytes)
 	if err != nil {
 		return "", err
 	}
 
-	pfx, err := gopkcs12.Modern.Encode(parsedKey, parsedCert, nil, pass)
+	pfx, err := EncodePKCS12(parsedKey, parsedCert, nil, pass)
 	if err != nil {
 		return "", err
 	}
 
 	return base64.StdEncoding.EncodeToString(pfx), nil
 }
+
+// EncodePKCS12 encodes a private key, its certificate and
+// the CA certificates into a PKCS#12 keystore.
+func EncodePKCS12(key interface{}, cert *x509.Certificate, caCerts []*x509.Certificate, pass string) ([]byte, error) {
+	return gopkcs12.Modern.Encode(key, cert, caCerts, pass)
+}
@@ -0,0 +1,197 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	errPropertiesUnicode = "malformed \\uXXXX encoding"
+	errPropertiesLine    = "unable to parse properties line %d: %w"
+)
+
+// toProperties renders a map as Java properties file.
+// Keys and values are escaped like java.util.Properties.store does,
+// including \uXXXX escapes for all non-ASCII characters,
+// so the result can be read with the ISO 8859-1 encoding.
+func toProperties(v interface{}) (string, error) {
+	m, err := toMap(v)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, k := range sortedKeys(m) {
+		val, err := scalarString(k, m[k])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(escapeProperty(k, true))
+		b.WriteByte('=')
+		b.WriteString(escapeProperty(val, false))
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
+func escapeProperty(s string, isKey bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch r {
+		case ' ':
+			if i == 0 || isKey {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(' ')
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\\', '=', ':', '#', '!':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		default:
+			if r >= 0x20 && r <= 0x7e {
+				b.WriteRune(r)
+				continue
+			}
+			if r > 0xffff {
+				r1, r2 := utf16.EncodeRune(r)
+				fmt.Fprintf(&b, `\u%04X\u%04X`, r1, r2)
+			} else {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			}
+		}
+	}
+	return b.String()
+}
+
+// fromProperties parses a Java properties file into a map,
+// following the rules of java.util.Properties.load:
+// comments, line continuations, the `=`, `:` and whitespace separators and escape sequences.
+func fromProperties(str string) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(str), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		// an odd number of trailing backslashes continues the line
+		for trailingBackslashes(line)%2 == 1 {
+			line = line[:len(line)-1]
+			i++
+			if i >= len(lines) {
+				break
+			}
+			line += strings.TrimLeft(lines[i], " \t\f")
+		}
+		key, val := splitProperty(line)
+		k, err := unescapeProperty(key)
+		if err != nil {
+			return nil, fmt.Errorf(errPropertiesLine, lineNo, err)
+		}
+		v, err := unescapeProperty(val)
+		if err != nil {
+			return nil, fmt.Errorf(errPropertiesLine, lineNo, err)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+func trailingBackslashes(s string) int {
+	n := 0
+	for i := len(s) - 1; i >= 0 && s[i] == '\\'; i-- {
+		n++
+	}
+	return n
+}
+
+// splitProperty splits a logical line at the first unescaped separator.
+func splitProperty(line string) (string, string) {
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.IndexByte("=: \t\f", line[i]) >= 0 {
+			end = i
+			break
+		}
+	}
+	key, rest := line[:end], line[end:]
+	rest = strings.TrimLeft(rest, " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+	return key, rest
+}
+
+func unescapeProperty(s string) (string, error) {
+	var b strings.Builder
+	var surrogate rune
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i == len(s)-1 {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", errors.New(errPropertiesUnicode)
+			}
+			code, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", errors.New(errPropertiesUnicode)
+			}
+			i += 4
+			r := rune(code)
+			// characters outside of the BMP are encoded as surrogate pairs
+			if utf16.IsSurrogate(r) && surrogate == 0 {
+				surrogate = r
+				continue
+			}
+			if surrogate != 0 {
+				r = utf16.DecodeRune(surrogate, r)
+				surrogate = 0
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), nil
+}
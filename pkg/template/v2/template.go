@@ -35,13 +35,40 @@ var tplFuncs = tpl.FuncMap{
 	"pemToPkcs12":     pemToPkcs12,
 	"pemToPkcs12Pass": pemToPkcs12Pass,
 
-	"filterPEM": filterPEM,
+	"filterPEM": FilterPEM,
 
 	"jwkPublicKeyPem":  jwkPublicKeyPem,
 	"jwkPrivateKeyPem": jwkPrivateKeyPem,
 
 	"toYaml":   toYAML,
 	"fromYaml": fromYAML,
+
+	"toEnv":          toEnv,
+	"fromEnv":        fromEnv,
+	"toProperties":   toProperties,
+	"fromProperties": fromProperties,
+	"toIni":          toIni,
+	"fromIni":        fromIni,
+	"toToml":         toToml,
+	"fromToml":       fromToml,
+
+	"bcryptHash":       bcryptHash,
+	"argon2idHash":     argon2idHash,
+	"scryptHash":       scryptHash,
+	"hmacSHA256":       hmacSHA256,
+	"hmacSHA384":       hmacSHA384,
+	"hmacSHA512":       hmacSHA512,
+	"ageEncrypt":       ageEncrypt,
+	"pgpEncrypt":       pgpEncrypt,
+	"encryptionDigest": encryptionDigest,
+}
+
+// formatEncoders render the map of a format scope into a single key.
+var formatEncoders = map[esapi.TemplateScope]func(interface{}) (string, error){
+	esapi.TemplateScopeEnv:        toEnv,
+	esapi.TemplateScopeProperties: toProperties,
+	esapi.TemplateScopeINI:        toIni,
+	esapi.TemplateScopeTOML:       toToml,
 }
 
 // So other templating calls can use the same extra functions.
@@ -51,12 +78,19 @@ func FuncMap() tpl.FuncMap {
 
 const (
 	errParse                = "unable to parse template at key %s: %s"
+	errParseLibrary         = "unable to parse library template %s: %w"
+	errIncludeDepth         = "unable to include template %s: exceeded maximum depth"
+	errIncludeNoLibrary     = "unable to include template %s: no secret templates are referenced"
 	errExecute              = "unable to execute template at key %s: %s"
 	errDecodePKCS12WithPass = "unable to decode pkcs12 with password: %s"
 	errDecodeCertWithPass   = "unable to decode pkcs12 certificate with password: %s"
 	errParsePrivKey         = "unable to parse private key type"
 
 	pemTypeCertificate = "CERTIFICATE"
+
+	maxIncludeDepth = 100
+
+	libraryShadowSuffix = "(target)"
 )
 
 func init() {
@@ -67,6 +101,14 @@ func init() {
 	for k, v := range sprigFuncs {
 		tplFuncs[k] = v
 	}
+	// the context functions are replaced when executing with a context.
+	for k, v := range (*Context)(nil).funcs() {
+		tplFuncs[k] = v
+	}
+	// include is replaced when executing with a library.
+	tplFuncs["include"] = func(name string, _ any) (string, error) {
+		return "", fmt.Errorf(errIncludeNoLibrary, name)
+	}
 }
 
 func applyToTarget(k, val string, target esapi.TemplateTarget, secret *corev1.Secret) {
@@ -81,9 +123,9 @@ func applyToTarget(k, val string, target esapi.TemplateTarget, secret *corev1.Se
 	}
 }
 
-func valueScopeApply(tplMap, data map[string][]byte, target esapi.TemplateTarget, secret *corev1.Secret) error {
+func valueScopeApply(tplMap, data map[string][]byte, opts Options, target esapi.TemplateTarget, secret *corev1.Secret) error {
 	for k, v := range tplMap {
-		val, err := execute(k, string(v), data)
+		val, err := execute(k, string(v), data, opts)
 		if err != nil {
 			return fmt.Errorf(errExecute, k, err)
 		}
@@ -92,8 +134,8 @@ func valueScopeApply(tplMap, data map[string][]byte, target esapi.TemplateTarget
 	return nil
 }
 
-func mapScopeApply(tpl string, data map[string][]byte, target esapi.TemplateTarget, secret *corev1.Secret) error {
-	val, err := execute(tpl, tpl, data)
+func mapScopeApply(tpl string, data map[string][]byte, opts Options, target esapi.TemplateTarget, secret *corev1.Secret) error {
+	val, err := execute(tpl, tpl, data, opts)
 	if err != nil {
 		return fmt.Errorf(errExecute, tpl, err)
 	}
@@ -108,39 +150,107 @@ func mapScopeApply(tpl string, data map[string][]byte, target esapi.TemplateTarg
 	return nil
 }
 
+// formatScopeApply renders each template to a map like the KeysAndValues scope
+// and encodes the map into the key of the template.
+func formatScopeApply(tplMap, data map[string][]byte, opts Options, encode func(interface{}) (string, error), target esapi.TemplateTarget, secret *corev1.Secret) error {
+	for k, v := range tplMap {
+		val, err := execute(k, string(v), data, opts)
+		if err != nil {
+			return fmt.Errorf(errExecute, k, err)
+		}
+		src := make(map[string]interface{})
+		err = yaml.Unmarshal(val, &src)
+		if err != nil {
+			return fmt.Errorf("could not unmarshal template to 'map[string]interface{}': %w", err)
+		}
+		out, err := encode(src)
+		if err != nil {
+			return fmt.Errorf(errExecute, k, err)
+		}
+		applyToTarget(k, out, target, secret)
+	}
+	return nil
+}
+
+// Options configure the execution of templates.
+type Options struct {
+	// Library contains named templates that can be used by the templates.
+	Library map[string]string
+	// Context describes the resources the templates are rendered for.
+	Context *Context
+}
+
 // Execute renders the secret data as template. If an error occurs processing is stopped immediately.
 func Execute(tpl, data map[string][]byte, scope esapi.TemplateScope, target esapi.TemplateTarget, secret *corev1.Secret) error {
+	return executeWithOptions(tpl, data, Options{}, scope, target, secret)
+}
+
+// ExecuteWithOptions returns a function that works like Execute,
+// but renders the templates with the given options.
+func ExecuteWithOptions(opts Options) func(tpl, data map[string][]byte, scope esapi.TemplateScope, target esapi.TemplateTarget, secret *corev1.Secret) error {
+	return func(tpl, data map[string][]byte, scope esapi.TemplateScope, target esapi.TemplateTarget, secret *corev1.Secret) error {
+		return executeWithOptions(tpl, data, opts, scope, target, secret)
+	}
+}
+
+func executeWithOptions(tpl, data map[string][]byte, opts Options, scope esapi.TemplateScope, target esapi.TemplateTarget, secret *corev1.Secret) error {
 	if tpl == nil {
 		return nil
 	}
 	switch scope {
 	case esapi.TemplateScopeKeysAndValues:
 		for _, v := range tpl {
-			err := mapScopeApply(string(v), data, target, secret)
+			err := mapScopeApply(string(v), data, opts, target, secret)
 			if err != nil {
 				return err
 			}
 		}
 	case esapi.TemplateScopeValues:
-		err := valueScopeApply(tpl, data, target, secret)
+		err := valueScopeApply(tpl, data, opts, target, secret)
+		if err != nil {
+			return err
+		}
+	case esapi.TemplateScopeEnv, esapi.TemplateScopeProperties, esapi.TemplateScopeINI, esapi.TemplateScopeTOML:
+		err := formatScopeApply(tpl, data, opts, formatEncoders[scope], target, secret)
 		if err != nil {
 			return err
 		}
 	default:
-		return fmt.Errorf("unknown scope '%v': expected 'Values' or 'KeysAndValues'", scope)
+		return fmt.Errorf("unknown scope '%v': expected 'Values', 'KeysAndValues', 'Env', 'Properties', 'INI' or 'TOML'", scope)
 	}
 	return nil
 }
 
-func execute(k, val string, data map[string][]byte) ([]byte, error) {
+// Validate parses the template at the given key without executing it.
+func Validate(k, val string) error {
+	_, err := tpl.New(k).Funcs(tplFuncs).Parse(val)
+	if err != nil {
+		return fmt.Errorf(errParse, k, err)
+	}
+	return nil
+}
+
+func execute(k, val string, data map[string][]byte, opts Options) ([]byte, error) {
+	// the conversion keeps the bytes, so binary values pass through templates unchanged.
 	strValData := make(map[string]string, len(data))
 	for k := range data {
 		strValData[k] = string(data[k])
 	}
 
-	t, err := tpl.New(k).
-		Funcs(tplFuncs).
-		Parse(val)
+	name := k
+	if _, ok := opts.Library[k]; ok {
+		// the library template of the same name must stay reachable
+		name = k + libraryShadowSuffix
+	}
+	t := tpl.New(name).Funcs(tplFuncs).Funcs(opts.Context.funcs())
+	if len(opts.Library) > 0 {
+		var err error
+		t, err = withLibrary(t, opts.Library)
+		if err != nil {
+			return nil, err
+		}
+	}
+	t, err := t.Parse(val)
 	if err != nil {
 		return nil, fmt.Errorf(errParse, k, err)
 	}
@@ -151,3 +261,31 @@ func execute(k, val string, data map[string][]byte) ([]byte, error) {
 	}
 	return buf.Bytes(), nil
 }
+
+// withLibrary adds the named templates of the library to the template set
+// and provides the include function to render them within a pipeline.
+func withLibrary(t *tpl.Template, library map[string]string) (*tpl.Template, error) {
+	// include does not share the recursion limit of the template action,
+	// so it is limited separately.
+	var depth int
+	t = t.Funcs(tpl.FuncMap{
+		"include": func(name string, data any) (string, error) {
+			if depth >= maxIncludeDepth {
+				return "", fmt.Errorf(errIncludeDepth, name)
+			}
+			depth++
+			defer func() { depth-- }()
+			var buf bytes.Buffer
+			if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+				return "", err
+			}
+			return buf.String(), nil
+		},
+	})
+	for name, text := range library {
+		if _, err := t.New(name).Parse(text); err != nil {
+			return nil, fmt.Errorf(errParseLibrary, name, err)
+		}
+	}
+	return t, nil
+}
@@ -15,6 +15,7 @@ limitations under the License.
 package template
 
 import (
+	"encoding/base64"
 	"os"
 	"strings"
 	"testing"
@@ -519,7 +520,124 @@ func TestExecuteInvalidTemplateScope(t *testing.T) {
 	sec := &corev1.Secret{}
 	err := Execute(map[string][]byte{"foo": []byte("bar")}, nil, "invalid", esapi.TemplateTargetData, sec)
 	require.Error(t, err)
-	assert.ErrorContains(t, err, "expected 'Values' or 'KeysAndValues'")
+	assert.ErrorContains(t, err, "expected 'Values', 'KeysAndValues', 'Env', 'Properties', 'INI' or 'TOML'")
+}
+
+func TestExecuteBinary(t *testing.T) {
+	binary := []byte{0xfe, 0xed, 0xfe, 0xed, 0x00, 0x00, 0x00, 0x02, 0xff}
+	sec := &corev1.Secret{
+		Data: make(map[string][]byte),
+	}
+	tpl := map[string][]byte{
+		"keystore.jks": []byte("{{ .keystore }}"),
+		"keystore.b64": []byte("{{ .keystore | b64enc }}"),
+	}
+	err := Execute(tpl, map[string][]byte{"keystore": binary}, esapi.TemplateScopeValues, esapi.TemplateTargetData, sec)
+	require.NoError(t, err)
+	assert.Equal(t, binary, sec.Data["keystore.jks"])
+	assert.Equal(t, base64.StdEncoding.EncodeToString(binary), string(sec.Data["keystore.b64"]))
+}
+
+func TestExecuteWithLibrary(t *testing.T) {
+	tbl := []struct {
+		name    string
+		library map[string]string
+		tpl     map[string][]byte
+		data    map[string][]byte
+		expData map[string][]byte
+		expErr  string
+	}{
+		{
+			name: "include library template",
+			library: map[string]string{
+				"dsn": "postgres://{{ .user }}:{{ .password }}@db",
+			},
+			tpl: map[string][]byte{
+				"dsn": []byte(`{{ include "dsn" . | upper }}`),
+			},
+			data: map[string][]byte{
+				"user":     []byte("foo"),
+				"password": []byte("bar"),
+			},
+			expData: map[string][]byte{
+				"dsn": []byte("POSTGRES://FOO:BAR@DB"),
+			},
+		},
+		{
+			name: "template action",
+			library: map[string]string{
+				"greeting": "hello {{ . }}",
+			},
+			tpl: map[string][]byte{
+				"greeting": []byte(`{{ template "greeting" .user }}`),
+			},
+			data: map[string][]byte{
+				"user": []byte("foo"),
+			},
+			expData: map[string][]byte{
+				"greeting": []byte("hello foo"),
+			},
+		},
+		{
+			name: "library template includes another",
+			library: map[string]string{
+				"inner": "{{ . | b64enc }}",
+				"outer": `Basic {{ include "inner" . }}`,
+			},
+			tpl: map[string][]byte{
+				"auth": []byte(`{{ include "outer" "foo:bar" }}`),
+			},
+			expData: map[string][]byte{
+				"auth": []byte("Basic Zm9vOmJhcg=="),
+			},
+		},
+		{
+			name: "recursive include",
+			library: map[string]string{
+				"loop": `{{ include "loop" . }}`,
+			},
+			tpl: map[string][]byte{
+				"loop": []byte(`{{ include "loop" . }}`),
+			},
+			expErr: "exceeded maximum depth",
+		},
+		{
+			name: "invalid library template",
+			library: map[string]string{
+				"broken": "{{ .foo ",
+			},
+			tpl: map[string][]byte{
+				"foo": []byte("bar"),
+			},
+			expErr: "unable to parse library template broken",
+		},
+		{
+			name: "unknown library template",
+			tpl: map[string][]byte{
+				"foo": []byte(`{{ include "missing" . }}`),
+			},
+			library: map[string]string{
+				"other": "bar",
+			},
+			expErr: `no template "missing"`,
+		},
+	}
+
+	for i := range tbl {
+		row := tbl[i]
+		t.Run(row.name, func(t *testing.T) {
+			sec := &corev1.Secret{
+				Data: make(map[string][]byte),
+			}
+			err := ExecuteWithOptions(Options{Library: row.library})(row.tpl, row.data, esapi.TemplateScopeValues, esapi.TemplateTargetData, sec)
+			if !ErrorContains(err, row.expErr) {
+				t.Errorf("unexpected error: %s, expected: %s", err, row.expErr)
+			}
+			if row.expData != nil {
+				assert.EqualValues(t, row.expData, sec.Data)
+			}
+		})
+	}
 }
 
 func TestScopeKeysAndValues(t *testing.T) {
@@ -0,0 +1,43 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+
+	"github.com/BurntSushi/toml"
+)
+
+// toToml renders a map as TOML document.
+func toToml(v interface{}) (string, error) {
+	m, err := toMap(v)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(normalizeNumbers(m)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// fromToml parses a TOML document into a map.
+func fromToml(str string) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	if _, err := toml.Decode(str, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,64 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"sort"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/template/cel"
+	v1 "github.com/external-secrets/external-secrets/pkg/template/v1"
+	v2 "github.com/external-secrets/external-secrets/pkg/template/v2"
+)
+
+func init() {
+	esapi.RegisterTemplateValidator(&Validator{})
+}
+
+// Validator validates the templates of ExternalSecrets in the admission webhook.
+// The templates are only parsed, rendering them could take unbounded time and memory,
+// e.g. with loops or expensive hash functions.
+type Validator struct{}
+
+// ValidateTemplate parses the templates with the given engine.
+func (v *Validator) ValidateTemplate(version esapi.TemplateEngineVersion, tpl map[string][]byte) error {
+	validate := validatorForVersion(version)
+	keys := make([]string, 0, len(tpl))
+	for k := range tpl {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := validate(k, string(tpl[k])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateTransform parses the template of a rewrite transform.
+func (v *Validator) ValidateTransform(tpl string) error {
+	return v2.Validate("transform", tpl)
+}
+
+func validatorForVersion(version esapi.TemplateEngineVersion) func(k, val string) error {
+	switch version {
+	case esapi.TemplateEngineV2:
+		return v2.Validate
+	case esapi.TemplateEngineCEL:
+		return cel.Validate
+	}
+	return v1.Validate
+}
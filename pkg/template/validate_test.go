@@ -0,0 +1,109 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"strings"
+	"testing"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		version esapi.TemplateEngineVersion
+		tpl     string
+		err     string
+	}{
+		{
+			name:    "v2 valid",
+			version: esapi.TemplateEngineV2,
+			tpl:     "{{ .foo | upper }}",
+		},
+		{
+			name:    "v2 undefined function",
+			version: esapi.TemplateEngineV2,
+			tpl:     "{{ .foo | uppr }}",
+			err:     `function "uppr" not defined`,
+		},
+		{
+			name:    "v2 syntax error",
+			version: esapi.TemplateEngineV2,
+			tpl:     "{{ .foo ",
+			err:     "unable to parse template at key key",
+		},
+		{
+			name:    "v2 is not rendered",
+			version: esapi.TemplateEngineV2,
+			tpl:     `{{ fail "boom" }}{{ range until 1000000000 }}{{ scryptHash "password" }}{{ end }}`,
+		},
+		{
+			name:    "v1 undefined function",
+			version: esapi.TemplateEngineV1,
+			tpl:     "{{ .foo | toYaml }}",
+			err:     `function "toYaml" not defined`,
+		},
+		{
+			name:    "v1 valid",
+			version: esapi.TemplateEngineV1,
+			tpl:     "{{ .foo | toString | upper }}",
+		},
+		{
+			name:    "cel undeclared reference",
+			version: esapi.TemplateEngineCEL,
+			tpl:     "data.foo.uppr()",
+			err:     "unable to compile expression at key key",
+		},
+		{
+			name:    "cel valid",
+			version: esapi.TemplateEngineCEL,
+			tpl:     "data.foo.upperAscii()",
+		},
+		{
+			name:    "cel is not evaluated",
+			version: esapi.TemplateEngineCEL,
+			tpl:     "data.missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &Validator{}
+			err := v.ValidateTemplate(tt.version, map[string][]byte{"key": []byte(tt.tpl)})
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateTransform(t *testing.T) {
+	v := &Validator{}
+	if err := v.ValidateTransform(`{{ .value | upper }}`); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := v.ValidateTransform(`{{ .tags.app }}_{{ .name }}_{{ .path }}_{{ .version }}`); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := v.ValidateTransform(`{{ .value | uppr }}`); err == nil {
+		t.Errorf("expected an error for an undefined function")
+	}
+}
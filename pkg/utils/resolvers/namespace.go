@@ -0,0 +1,66 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const errGetNamespace = "cannot get namespace %q: %w"
+
+// NamespaceMatchesConditions returns true if the namespace is allowed by the conditions
+// of a cluster scoped resource, e.g. a ClusterSecretStore. A namespace is allowed if
+// there are no conditions or if it is listed in or selected by any of the conditions.
+// The namespace is only fetched if a label selector has to be evaluated.
+func NamespaceMatchesConditions(ctx context.Context, c client.Client, conditions []esv1beta1.ClusterSecretStoreCondition, namespace string) (bool, error) {
+	if len(conditions) == 0 {
+		return true, nil
+	}
+
+	var ns *corev1.Namespace
+	for _, condition := range conditions {
+		if slices.Contains(condition.Namespaces, namespace) {
+			return true, nil
+		}
+		if condition.NamespaceSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(condition.NamespaceSelector)
+		if err != nil {
+			return false, err
+		}
+		if ns == nil {
+			ns = &corev1.Namespace{}
+			if err := c.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+				return false, fmt.Errorf(errGetNamespace, namespace, err)
+			}
+		}
+		if selector.Matches(labels.Set(ns.Labels)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
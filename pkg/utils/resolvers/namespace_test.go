@@ -0,0 +1,95 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestNamespaceMatchesConditions(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "labeled",
+			Labels: map[string]string{"team": "a"},
+		},
+	}).Build()
+	teamA := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+
+	testCases := []struct {
+		name       string
+		conditions []esv1beta1.ClusterSecretStoreCondition
+		namespace  string
+		expected   bool
+		err        bool
+	}{
+		{
+			name:      "no conditions",
+			namespace: "missing",
+			expected:  true,
+		},
+		{
+			name:       "listed namespace",
+			conditions: []esv1beta1.ClusterSecretStoreCondition{{Namespaces: []string{"other", "missing"}}},
+			namespace:  "missing",
+			expected:   true,
+		},
+		{
+			name:       "unlisted namespace",
+			conditions: []esv1beta1.ClusterSecretStoreCondition{{Namespaces: []string{"other"}}},
+			namespace:  "labeled",
+			expected:   false,
+		},
+		{
+			name:       "selected namespace",
+			conditions: []esv1beta1.ClusterSecretStoreCondition{{Namespaces: []string{"other"}}, {NamespaceSelector: teamA}},
+			namespace:  "labeled",
+			expected:   true,
+		},
+		{
+			name: "not selected namespace",
+			conditions: []esv1beta1.ClusterSecretStoreCondition{{NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "b"},
+			}}},
+			namespace: "labeled",
+			expected:  false,
+		},
+		{
+			name:       "missing namespace with a selector",
+			conditions: []esv1beta1.ClusterSecretStoreCondition{{NamespaceSelector: teamA}},
+			namespace:  "missing",
+			err:        true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowed, err := NamespaceMatchesConditions(context.Background(), c, tc.conditions, tc.namespace)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, allowed)
+		})
+	}
+}
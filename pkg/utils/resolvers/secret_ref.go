@@ -31,8 +31,7 @@ const (
 	// This is used to determine if a store is cluster-scoped or not.
 	// The EmptyStoreKind is not cluster-scoped, hence resources
 	// cannot be resolved across namespaces.
-	// TODO: when we implement cluster-scoped generators
-	// we can remove this and replace it with a interface.
+	// It is used by namespaced generators, see GeneratorStoreKind.
 	EmptyStoreKind = "EmptyStoreKind"
 
 	errGetKubeSecret         = "cannot get Kubernetes secret %q: %w"
@@ -69,3 +68,22 @@ func SecretKeyRef(
 	}
 	return string(val), nil
 }
+
+type generatorStoreKindKey struct{}
+
+// WithGeneratorStoreKind returns a context in which generators resolve the references
+// of their spec with the semantics of the given store kind.
+// Generators wrapped by a ClusterGenerator use ClusterSecretStoreKind,
+// so references may point to other namespaces like they do in a ClusterSecretStore.
+func WithGeneratorStoreKind(ctx context.Context, storeKind string) context.Context {
+	return context.WithValue(ctx, generatorStoreKindKey{}, storeKind)
+}
+
+// GeneratorStoreKind returns the store kind generators resolve their references with.
+// It defaults to EmptyStoreKind, which does not allow to resolve references across namespaces.
+func GeneratorStoreKind(ctx context.Context) string {
+	if storeKind, ok := ctx.Value(generatorStoreKindKey{}).(string); ok {
+		return storeKind
+	}
+	return EmptyStoreKind
+}
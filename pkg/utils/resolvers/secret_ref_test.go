@@ -26,6 +26,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
 )
 
@@ -127,3 +128,12 @@ func TestResolveSecretKeyRef(t *testing.T) {
 		})
 	}
 }
+
+func TestGeneratorStoreKind(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, EmptyStoreKind, GeneratorStoreKind(ctx))
+	ctx = WithGeneratorStoreKind(ctx, esv1beta1.ClusterSecretStoreKind)
+	assert.Equal(t, esv1beta1.ClusterSecretStoreKind, GeneratorStoreKind(ctx))
+	ctx = WithGeneratorStoreKind(ctx, EmptyStoreKind)
+	assert.Equal(t, EmptyStoreKind, GeneratorStoreKind(ctx))
+}
@@ -67,72 +67,254 @@ func MergeByteMap(dst, src map[string][]byte) map[string][]byte {
 }
 
 func RewriteMap(operations []esv1beta1.ExternalSecretRewrite, in map[string][]byte) (map[string][]byte, error) {
+	return RewriteMapWithMetadata(operations, in, nil)
+}
+
+// RewriteMapWithMetadata applies the rewrite operations to the keys of the secret map.
+// Transform templates receive the metadata of the secret each key was found at,
+// it is carried along when a key is rewritten.
+func RewriteMapWithMetadata(operations []esv1beta1.ExternalSecretRewrite, in map[string][]byte, metadata map[string]esv1beta1.SecretMetadata) (map[string][]byte, error) {
 	out := in
+	meta := make(map[string]keyMetadata, len(in))
+	for k := range in {
+		meta[k] = newKeyMetadata(k, metadata[k])
+	}
 	var err error
 	for i, op := range operations {
-		if op.Regexp != nil {
-			out, err = RewriteRegexp(*op.Regexp, out)
-			if err != nil {
-				return nil, fmt.Errorf("failed rewriting regexp operation[%v]: %w", i, err)
-			}
-		}
-		if op.Transform != nil {
-			out, err = RewriteTransform(*op.Transform, out)
-			if err != nil {
-				return nil, fmt.Errorf("failed rewriting transform operation[%v]: %w", i, err)
-			}
+		out, meta, err = rewrite(i, op, out, meta)
+		if err != nil {
+			return nil, err
 		}
 	}
 	return out, nil
 }
 
+// metadataFieldsRegexp matches the fields of transform templates which are only
+// meaningful if the provider returns the metadata of the secrets.
+var metadataFieldsRegexp = regexp.MustCompile(`\.(path|tags|version)\b`)
+
+// RewriteUsesMetadata returns true if a transform of the rewrite operations
+// refers to the .path, .tags or .version of the secrets.
+func RewriteUsesMetadata(operations []esv1beta1.ExternalSecretRewrite) bool {
+	for _, op := range operations {
+		if op.Transform != nil && metadataFieldsRegexp.MatchString(op.Transform.Template) {
+			return true
+		}
+	}
+	return false
+}
+
+// rewrite applies a single rewrite to the keys matching its condition,
+// the other keys are passed on unchanged.
+func rewrite(i int, op esv1beta1.ExternalSecretRewrite, in map[string][]byte, meta map[string]keyMetadata) (map[string][]byte, map[string]keyMetadata, error) {
+	out, unmatched, err := matchKeys(op.Match, in)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed matching keys of operation[%v]: %w", i, err)
+	}
+	outMeta := meta
+	if op.Regexp != nil {
+		out, outMeta, err = rewriteRegexp(*op.Regexp, out, outMeta)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed rewriting regexp operation[%v]: %w", i, err)
+		}
+	}
+	if op.Transform != nil {
+		out, outMeta, err = rewriteTransform(*op.Transform, out, outMeta)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed rewriting transform operation[%v]: %w", i, err)
+		}
+	}
+	if op.Filter != nil {
+		out, outMeta, err = rewriteFilter(*op.Filter, out, outMeta)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed rewriting filter operation[%v]: %w", i, err)
+		}
+	}
+	if op.Merge != nil {
+		out, outMeta, err = rewriteMerge(*op.Merge, out, outMeta)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed rewriting merge operation[%v]: %w", i, err)
+		}
+	}
+	if len(unmatched) == 0 {
+		return out, outMeta, nil
+	}
+	// rewritten keys take precedence over unmatched keys of the same name.
+	result := make(map[string][]byte, len(unmatched)+len(out))
+	resultMeta := make(map[string]keyMetadata, len(unmatched)+len(out))
+	for k, v := range unmatched {
+		result[k] = v
+		resultMeta[k] = meta[k]
+	}
+	for k, v := range out {
+		result[k] = v
+		resultMeta[k] = outMeta[k]
+	}
+	return result, resultMeta, nil
+}
+
+// matchKeys splits the keys into the ones matching the regular expression and all others.
+// All keys match an empty expression.
+func matchKeys(match string, in map[string][]byte) (map[string][]byte, map[string][]byte, error) {
+	if match == "" {
+		return in, nil, nil
+	}
+	re, err := regexp.Compile(match)
+	if err != nil {
+		return nil, nil, err
+	}
+	matched := make(map[string][]byte)
+	unmatched := make(map[string][]byte)
+	for k, v := range in {
+		if re.MatchString(k) {
+			matched[k] = v
+		} else {
+			unmatched[k] = v
+		}
+	}
+	return matched, unmatched, nil
+}
+
+// keyMetadata is the metadata of a key during the rewrite operations.
+type keyMetadata struct {
+	// name is the key as returned by the provider.
+	name string
+	esv1beta1.SecretMetadata
+}
+
+func newKeyMetadata(key string, metadata esv1beta1.SecretMetadata) keyMetadata {
+	if metadata.Path == "" {
+		metadata.Path = key
+	}
+	if metadata.Tags == nil {
+		metadata.Tags = map[string]string{}
+	}
+	return keyMetadata{name: key, SecretMetadata: metadata}
+}
+
 // RewriteRegexp rewrites a single Regexp Rewrite Operation.
 func RewriteRegexp(operation esv1beta1.ExternalSecretRewriteRegexp, in map[string][]byte) (map[string][]byte, error) {
+	out, _, err := rewriteRegexp(operation, in, nil)
+	return out, err
+}
+
+func rewriteRegexp(operation esv1beta1.ExternalSecretRewriteRegexp, in map[string][]byte, meta map[string]keyMetadata) (map[string][]byte, map[string]keyMetadata, error) {
 	out := make(map[string][]byte)
+	outMeta := make(map[string]keyMetadata)
 	re, err := regexp.Compile(operation.Source)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for key, value := range in {
 		newKey := re.ReplaceAllString(key, operation.Target)
 		out[newKey] = value
+		outMeta[newKey] = meta[key]
 	}
-	return out, nil
+	return out, outMeta, nil
 }
 
 // RewriteTransform applies string transformation on each secret key name to rewrite.
+// The template receives the key as `.value`, as well as the `.name`, `.path`,
+// `.tags` and `.version` of the secret. Without metadata the name and path are the key.
 func RewriteTransform(operation esv1beta1.ExternalSecretRewriteTransform, in map[string][]byte) (map[string][]byte, error) {
+	out, _, err := rewriteTransform(operation, in, nil)
+	return out, err
+}
+
+func rewriteTransform(operation esv1beta1.ExternalSecretRewriteTransform, in map[string][]byte, meta map[string]keyMetadata) (map[string][]byte, map[string]keyMetadata, error) {
 	out := make(map[string][]byte)
+	outMeta := make(map[string]keyMetadata)
 	for key, value := range in {
-		data := map[string][]byte{
-			"value": []byte(key),
+		m, ok := meta[key]
+		if !ok {
+			m = newKeyMetadata(key, esv1beta1.SecretMetadata{})
+		}
+		data := map[string]any{
+			"value":   key,
+			"name":    m.name,
+			"path":    m.Path,
+			"tags":    m.Tags,
+			"version": m.Version,
 		}
 
 		result, err := transform(operation.Template, data)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		newKey := string(result)
 		out[newKey] = value
+		outMeta[newKey] = m
 	}
-	return out, nil
+	return out, outMeta, nil
 }
 
-func transform(val string, data map[string][]byte) ([]byte, error) {
-	strValData := make(map[string]string, len(data))
-	for k := range data {
-		strValData[k] = string(data[k])
+// RewriteFilter keeps or drops the keys matching a single Filter Rewrite Operation.
+func RewriteFilter(operation esv1beta1.ExternalSecretRewriteFilter, in map[string][]byte) (map[string][]byte, error) {
+	out, _, err := rewriteFilter(operation, in, nil)
+	return out, err
+}
+
+func rewriteFilter(operation esv1beta1.ExternalSecretRewriteFilter, in map[string][]byte, meta map[string]keyMetadata) (map[string][]byte, map[string]keyMetadata, error) {
+	out := make(map[string][]byte)
+	outMeta := make(map[string]keyMetadata)
+	re, err := regexp.Compile(operation.Source)
+	if err != nil {
+		return nil, nil, err
 	}
+	keep := operation.Action != esv1beta1.RewriteFilterDrop
+	for key, value := range in {
+		if re.MatchString(key) == keep {
+			out[key] = value
+			outMeta[key] = meta[key]
+		}
+	}
+	return out, outMeta, nil
+}
+
+// RewriteMerge unpacks the JSON object of each value into multiple keys.
+func RewriteMerge(operation esv1beta1.ExternalSecretRewriteMerge, in map[string][]byte) (map[string][]byte, error) {
+	out, _, err := rewriteMerge(operation, in, nil)
+	return out, err
+}
+
+func rewriteMerge(operation esv1beta1.ExternalSecretRewriteMerge, in map[string][]byte, meta map[string]keyMetadata) (map[string][]byte, map[string]keyMetadata, error) {
+	out := make(map[string][]byte)
+	outMeta := make(map[string]keyMetadata)
+	for key, value := range in {
+		var obj map[string]any
+		decoder := json.NewDecoder(bytes.NewReader(value))
+		decoder.UseNumber()
+		if err := decoder.Decode(&obj); err != nil {
+			return nil, nil, fmt.Errorf("unable to unpack key %s: %w", key, err)
+		}
+		for k, v := range obj {
+			newKey := operation.Prefix + k
+			if _, exists := out[newKey]; exists {
+				return nil, nil, fmt.Errorf("secret name collision during merge: %s", newKey)
+			}
+			val, err := GetByteValue(v)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to unpack key %s: %w", key, err)
+			}
+			out[newKey] = val
+			outMeta[newKey] = meta[key]
+		}
+	}
+	return out, outMeta, nil
+}
 
+func transform(val string, data map[string]any) ([]byte, error) {
+	// missing tags render as empty string instead of "<no value>".
 	t, err := tpl.New("transform").
+		Option("missingkey=zero").
 		Funcs(template.FuncMap()).
 		Parse(val)
 	if err != nil {
 		return nil, fmt.Errorf(errParse, err)
 	}
 	buf := bytes.NewBuffer(nil)
-	err = t.Execute(buf, strValData)
+	err = t.Execute(buf, data)
 	if err != nil {
 		return nil, fmt.Errorf(errExecute, err)
 	}
@@ -215,6 +397,15 @@ func ConvertKeys(strategy esv1beta1.ExternalSecretConversionStrategy, in map[str
 	return out, nil
 }
 
+// ConvertMetadataKeys converts the keys of the secret metadata like ConvertKeys.
+func ConvertMetadataKeys(strategy esv1beta1.ExternalSecretConversionStrategy, in map[string]esv1beta1.SecretMetadata) map[string]esv1beta1.SecretMetadata {
+	out := make(map[string]esv1beta1.SecretMetadata, len(in))
+	for k, v := range in {
+		out[convert(strategy, k)] = v
+	}
+	return out
+}
+
 func convert(strategy esv1beta1.ExternalSecretConversionStrategy, str string) string {
 	rs := []rune(str)
 	newName := make([]string, len(rs))
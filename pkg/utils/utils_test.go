@@ -602,6 +602,144 @@ func TestRewrite(t *testing.T) {
 				"key_foo": []byte("barr"),
 			},
 		},
+		{
+			name: "only rewrite keys matching the condition",
+			args: args{
+				operations: []esv1beta1.ExternalSecretRewrite{
+					{
+						Match: "^legacy/",
+						Regexp: &esv1beta1.ExternalSecretRewriteRegexp{
+							Source: "^legacy/(.*)",
+							Target: "old_$1",
+						},
+					},
+					{
+						Match: "^new-",
+						Transform: &esv1beta1.ExternalSecretRewriteTransform{
+							Template: `{{ .value | upper }}`,
+						},
+					},
+				},
+				in: map[string][]byte{
+					"legacy/key": []byte("foo"),
+					"new-key":    []byte("bar"),
+					"other":      []byte("baz"),
+				},
+			},
+			want: map[string][]byte{
+				"old_key": []byte("foo"),
+				"NEW-KEY": []byte("bar"),
+				"other":   []byte("baz"),
+			},
+		},
+		{
+			name: "keep keys matching a filter",
+			args: args{
+				operations: []esv1beta1.ExternalSecretRewrite{
+					{
+						Filter: &esv1beta1.ExternalSecretRewriteFilter{
+							Source: "^app_",
+						},
+					},
+				},
+				in: map[string][]byte{
+					"app_key":  []byte("foo"),
+					"app_pass": []byte("bar"),
+					"other":    []byte("baz"),
+				},
+			},
+			want: map[string][]byte{
+				"app_key":  []byte("foo"),
+				"app_pass": []byte("bar"),
+			},
+		},
+		{
+			name: "drop keys matching a filter",
+			args: args{
+				operations: []esv1beta1.ExternalSecretRewrite{
+					{
+						Filter: &esv1beta1.ExternalSecretRewriteFilter{
+							Source: "_backup$",
+							Action: esv1beta1.RewriteFilterDrop,
+						},
+					},
+				},
+				in: map[string][]byte{
+					"db":        []byte("foo"),
+					"db_backup": []byte("bar"),
+				},
+			},
+			want: map[string][]byte{
+				"db": []byte("foo"),
+			},
+		},
+		{
+			name: "merge json values of matching keys with a prefix",
+			args: args{
+				operations: []esv1beta1.ExternalSecretRewrite{
+					{
+						Match: "^db$",
+						Merge: &esv1beta1.ExternalSecretRewriteMerge{
+							Prefix: "db_",
+						},
+					},
+				},
+				in: map[string][]byte{
+					"db":    []byte(`{"user":"admin","port":5432,"tls":true,"hosts":["a","b"]}`),
+					"token": []byte("baz"),
+				},
+			},
+			want: map[string][]byte{
+				"db_user":  []byte("admin"),
+				"db_port":  []byte("5432"),
+				"db_tls":   []byte("true"),
+				"db_hosts": []byte(`["a","b"]`),
+				"token":    []byte("baz"),
+			},
+		},
+		{
+			name: "merge fails on values which are not json objects",
+			args: args{
+				operations: []esv1beta1.ExternalSecretRewrite{
+					{
+						Merge: &esv1beta1.ExternalSecretRewriteMerge{},
+					},
+				},
+				in: map[string][]byte{
+					"token": []byte("baz"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "merge fails on colliding keys",
+			args: args{
+				operations: []esv1beta1.ExternalSecretRewrite{
+					{
+						Merge: &esv1beta1.ExternalSecretRewriteMerge{},
+					},
+				},
+				in: map[string][]byte{
+					"a": []byte(`{"user":"foo"}`),
+					"b": []byte(`{"user":"bar"}`),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid match condition",
+			args: args{
+				operations: []esv1beta1.ExternalSecretRewrite{
+					{
+						Match: "(",
+					},
+				},
+				in: map[string][]byte{
+					"a": []byte("foo"),
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -617,6 +755,74 @@ func TestRewrite(t *testing.T) {
 	}
 }
 
+func TestRewriteWithMetadata(t *testing.T) {
+	operations := []esv1beta1.ExternalSecretRewrite{
+		{
+			Regexp: &esv1beta1.ExternalSecretRewriteRegexp{
+				Source: "^team/",
+				Target: "",
+			},
+		},
+		{
+			Transform: &esv1beta1.ExternalSecretRewriteTransform{
+				Template: `{{ .tags.app }}_{{ .value }}_{{ .version }}`,
+			},
+		},
+		{
+			Transform: &esv1beta1.ExternalSecretRewriteTransform{
+				Template: `{{ .value }}_{{ .name | replace "/" "-" }}_{{ .path | base }}`,
+			},
+		},
+	}
+	in := map[string][]byte{
+		"team/db":  []byte("foo"),
+		"team/api": []byte("bar"),
+	}
+	metadata := map[string]esv1beta1.SecretMetadata{
+		"team/db": {
+			Path:    "kv/team/db",
+			Tags:    map[string]string{"app": "billing"},
+			Version: "3",
+		},
+	}
+	want := map[string][]byte{
+		"billing_db_3_team-db_db": []byte("foo"),
+		"_api__team-api_api":      []byte("bar"),
+	}
+	got, err := RewriteMapWithMetadata(operations, in, metadata)
+	if err != nil {
+		t.Fatalf("RewriteMapWithMetadata() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RewriteMapWithMetadata() = %v, want %v", got, want)
+	}
+}
+
+func TestRewriteUsesMetadata(t *testing.T) {
+	transform := func(tpl string) []esv1beta1.ExternalSecretRewrite {
+		return []esv1beta1.ExternalSecretRewrite{
+			{Regexp: &esv1beta1.ExternalSecretRewriteRegexp{Source: "tags", Target: "path"}},
+			{Transform: &esv1beta1.ExternalSecretRewriteTransform{Template: tpl}},
+		}
+	}
+	tests := map[string]bool{
+		`{{ .value | upper }}`:         false,
+		`{{ .name }}`:                  false,
+		`{{ .tags.app }}_{{ .value }}`: true,
+		`{{ .path | base }}`:           true,
+		`{{ .value }}_{{ .version }}`:  true,
+		`{{ .value }}.tagsuffix`:       false,
+	}
+	for tpl, want := range tests {
+		if got := RewriteUsesMetadata(transform(tpl)); got != want {
+			t.Errorf("RewriteUsesMetadata(%q) = %v, want %v", tpl, got, want)
+		}
+	}
+	if RewriteUsesMetadata(nil) {
+		t.Errorf("RewriteUsesMetadata(nil) = true, want false")
+	}
+}
+
 func TestReverse(t *testing.T) {
 	type args struct {
 		strategy esv1alpha1.PushSecretConversionStrategy